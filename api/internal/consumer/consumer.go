@@ -43,6 +43,10 @@ type Consumer struct {
 	generation      int
 	ConsumerConfigs map[string]api.PluginConsumerConfig
 	FilterConfigs   map[string]*fmModel.ParsedFilterConfig
+	// exported is the consumer used in the namespaces which import it
+	exported *Consumer
+	// isExported is true if this consumer is used outside its namespace
+	isExported bool
 
 	// fields that generated from the configuration
 	FilterNames        []string
@@ -104,11 +108,41 @@ func (c *Consumer) InitConfigs() error {
 	return nil
 }
 
+// newExported creates the consumer used in the namespaces which import it. It doesn't share
+// the parsed configs with the local one, so that the configs are initialized separately.
+func (c *Consumer) newExported() (*Consumer, error) {
+	exported := &Consumer{
+		Consumer:   c.Consumer,
+		namespace:  c.namespace,
+		name:       c.name,
+		generation: c.generation,
+		isExported: true,
+	}
+	if err := exported.InitConfigs(); err != nil {
+		return nil, err
+	}
+	return exported, nil
+}
+
 // Implement pkg.filtermanager.api.Consumer
+// The consumer used outside its namespace is named as `namespace/name`, so that it won't be mixed up
+// with the consumer with the same name in the route's namespace.
 func (c *Consumer) Name() string {
+	if c.isExported {
+		return c.NamespacedName()
+	}
 	return c.name
 }
 
+// NamespacedName returns the name of the consumer which is unique across namespaces
+func (c *Consumer) NamespacedName() string {
+	return c.namespace + "/" + c.name
+}
+
 func (c *Consumer) PluginConfig(name string) api.PluginConsumerConfig {
 	return c.ConsumerConfigs[name]
 }
+
+func (c *Consumer) Metadata() map[string]string {
+	return c.Consumer.Metadata
+}
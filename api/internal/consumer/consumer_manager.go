@@ -16,10 +16,12 @@ package consumer
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"google.golang.org/protobuf/types/known/structpb"
 
+	csModel "mosn.io/htnn/api/pkg/consumer/model"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 )
 
@@ -27,6 +29,9 @@ var (
 	indexMutex    sync.RWMutex
 	resourceIndex = make(map[string]map[string]*Consumer)
 	scopeIndex    map[string]map[string]map[string]*Consumer
+	// exportedScopeIndex contains the consumers exported from other namespaces. As the consumers
+	// are only visible to the namespaces which import them, all the candidates are kept.
+	exportedScopeIndex map[string]map[string]map[string][]*Consumer
 )
 
 func UpdateConsumers(value *structpb.Struct) {
@@ -67,6 +72,13 @@ func UpdateConsumers(value *structpb.Struct) {
 				}
 
 				c.generation = v
+				if len(c.ExportTo) > 0 {
+					c.exported, err = c.newExported()
+					if err != nil {
+						logger.Error(err, "failed to init exported", "consumer", s, "name", name, "namespace", ns)
+						continue
+					}
+				}
 				newIdx[name] = &c
 			} else {
 				newIdx[name] = currValue
@@ -80,41 +92,118 @@ func UpdateConsumers(value *structpb.Struct) {
 	for ns, nsValue := range resourceIndex {
 		nsScopeIdx := make(map[string]map[string]*Consumer)
 		for _, value := range nsValue {
-			for pluginName, cfg := range value.ConsumerConfigs {
+			addToScopeIndex(nsScopeIdx, value)
+		}
+		scopeIndex[ns] = nsScopeIdx
+	}
+
+	// build the idx for the consumers which are exported to other namespaces.
+	// Sort the consumers so that the result is stable when there is collision.
+	exported := []*Consumer{}
+	for _, nsValue := range resourceIndex {
+		for _, value := range nsValue {
+			if len(value.ExportTo) > 0 {
+				exported = append(exported, value)
+			}
+		}
+	}
+	sort.Slice(exported, func(i, j int) bool {
+		if exported[i].namespace != exported[j].namespace {
+			return exported[i].namespace < exported[j].namespace
+		}
+		return exported[i].name < exported[j].name
+	})
+
+	exportedScopeIndex = make(map[string]map[string]map[string][]*Consumer)
+	for _, value := range exported {
+		for _, ns := range value.ExportTo {
+			if ns == value.namespace {
+				// already in the namespace-local index
+				continue
+			}
+
+			nsScopeIdx := exportedScopeIndex[ns]
+			if nsScopeIdx == nil {
+				nsScopeIdx = make(map[string]map[string][]*Consumer)
+				exportedScopeIndex[ns] = nsScopeIdx
+			}
+			for pluginName, cfg := range value.exported.ConsumerConfigs {
 				pluginScopeIdx := nsScopeIdx[pluginName]
 				if pluginScopeIdx == nil {
-					pluginScopeIdx = make(map[string]*Consumer)
+					pluginScopeIdx = make(map[string][]*Consumer)
 					nsScopeIdx[pluginName] = pluginScopeIdx
 				}
-
 				idx := cfg.Index()
-				if pluginScopeIdx[idx] != nil {
-					// TODO: find an effective way to detect collision in the control plane
-					err := fmt.Errorf("duplicate index %s", value.name)
-					logger.Error(err, fmt.Sprintf("ignore consumer %s for plugin %s", pluginName, idx),
-						"namespace", ns, "existing consumer", pluginScopeIdx[idx].name)
-					continue
-				}
-				pluginScopeIdx[idx] = value
+				pluginScopeIdx[idx] = append(pluginScopeIdx[idx], value.exported)
 			}
 		}
-		scopeIndex[ns] = nsScopeIdx
 	}
 }
 
-// LookupConsumer returns the consumer config for the given namespace, plugin name and key.
-func LookupConsumer(ns, pluginName, key string) (api.Consumer, bool) {
-	indexMutex.RLock()
-	defer indexMutex.RUnlock()
+func addToScopeIndex(nsScopeIdx map[string]map[string]*Consumer, value *Consumer) {
+	for pluginName, cfg := range value.ConsumerConfigs {
+		pluginScopeIdx := nsScopeIdx[pluginName]
+		if pluginScopeIdx == nil {
+			pluginScopeIdx = make(map[string]*Consumer)
+			nsScopeIdx[pluginName] = pluginScopeIdx
+		}
 
-	if nsIdx, ok := scopeIndex[ns]; ok {
+		idx := cfg.Index()
+		if pluginScopeIdx[idx] != nil {
+			// TODO: find an effective way to detect collision in the control plane
+			err := fmt.Errorf("duplicate index %s", value.name)
+			logger.Error(err, fmt.Sprintf("ignore consumer %s for plugin %s", pluginName, idx),
+				"namespace", value.namespace, "existing consumer", pluginScopeIdx[idx].name,
+				"existing consumer namespace", pluginScopeIdx[idx].namespace)
+			continue
+		}
+		pluginScopeIdx[idx] = value
+	}
+}
+
+func lookupConsumerInIndex(idx map[string]map[string]map[string]*Consumer, ns, pluginName, key string) (*Consumer, bool) {
+	if nsIdx, ok := idx[ns]; ok {
 		if pluginIdx, ok := nsIdx[pluginName]; ok {
-			// return extra bool to indicate whether the key exists so user doesn't need to
-			// distinguish nil interface.
-			// An interface in Go is nil only when both its type and value are nil.
 			c, ok := pluginIdx[key]
 			return c, ok
 		}
 	}
 	return nil, false
 }
+
+func lookupExportedConsumerInIndex(ns string, importFrom []string, pluginName, key string) (*Consumer, bool) {
+	for _, c := range exportedScopeIndex[ns][pluginName][key] {
+		for _, from := range importFrom {
+			if from == csModel.ImportFromAllNamespaces || from == c.namespace {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// LookupConsumer returns the consumer config for the given namespace, plugin name and key.
+// The consumers in the given namespace take precedence over the consumers exported to this namespace
+// explicitly, which take precedence over the consumers exported to all namespaces via "*".
+// The consumers exported from other namespaces are only visible when their namespaces are in importFrom.
+func LookupConsumer(ns string, importFrom []string, pluginName, key string) (api.Consumer, bool) {
+	indexMutex.RLock()
+	defer indexMutex.RUnlock()
+
+	// return extra bool to indicate whether the key exists so user doesn't need to
+	// distinguish nil interface.
+	// An interface in Go is nil only when both its type and value are nil.
+	if c, ok := lookupConsumerInIndex(scopeIndex, ns, pluginName, key); ok {
+		return c, true
+	}
+	if len(importFrom) == 0 {
+		return nil, false
+	}
+	if c, ok := lookupExportedConsumerInIndex(ns, importFrom, pluginName, key); ok {
+		return c, true
+	}
+	if c, ok := lookupExportedConsumerInIndex(csModel.ExportToAllNamespaces, importFrom, pluginName, key); ok {
+		return c, true
+	}
+	return nil, false
+}
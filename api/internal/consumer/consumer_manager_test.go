@@ -15,6 +15,7 @@
 package consumer
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -71,27 +72,27 @@ func TestUpdateConsumer(t *testing.T) {
 	v := newConsumerTest().Add("ns", c).Build()
 	UpdateConsumers(v)
 
-	r, _ := LookupConsumer("ns", "consumerPluginX", "test")
+	r, _ := LookupConsumer("ns", nil, "consumerPluginX", "test")
 	require.NotNil(t, r)
 	require.Equal(t, "me", r.Name())
 
-	r, _ = LookupConsumer("ns", "consumerPluginX", "not_found")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "not_found")
 	require.Nil(t, r)
 
 	// no change
 	c.Auth["consumerPluginX"] = string("{\"key\": \"two\"}")
 	v = newConsumerTest().Add("ns", c).Build()
 	UpdateConsumers(v)
-	r, _ = LookupConsumer("ns", "consumerPluginX", "test")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "test")
 	require.Equal(t, "me", r.Name())
 
 	// update
 	c.generation = 2
 	v = newConsumerTest().Add("ns", c).Build()
 	UpdateConsumers(v)
-	r, _ = LookupConsumer("ns", "consumerPluginX", "test")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "test")
 	require.Nil(t, r)
-	r, _ = LookupConsumer("ns", "consumerPluginX", "two")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "two")
 	require.Equal(t, "me", r.Name())
 
 	// remove
@@ -99,8 +100,125 @@ func TestUpdateConsumer(t *testing.T) {
 	c.generation = 3
 	v = newConsumerTest().Add("ns", c).Build()
 	UpdateConsumers(v)
-	r, _ = LookupConsumer("ns", "consumerPluginX", "me")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "me")
 	require.Nil(t, r)
-	r, _ = LookupConsumer("ns", "consumerPluginX", "two")
+	r, _ = LookupConsumer("ns", nil, "consumerPluginX", "two")
 	require.Equal(t, "you", r.Name())
 }
+
+func TestLookupExportedConsumer(t *testing.T) {
+	plugins.RegisterPlugin("consumerPluginX", &consumerPlugin{})
+
+	// clean index
+	resourceIndex = make(map[string]map[string]*Consumer)
+
+	newConsumer := func(name string, key string, exportTo ...string) *Consumer {
+		return &Consumer{
+			name:       name,
+			generation: 1,
+			Consumer: model.Consumer{
+				Auth: map[string]string{
+					"consumerPluginX": fmt.Sprintf(`{"key": "%s"}`, key),
+				},
+				ExportTo: exportTo,
+			},
+		}
+	}
+
+	v := newConsumerTest().
+		Add("ns", newConsumer("local", "shared")).
+		Add("partner", newConsumer("partner", "shared", "*")).
+		Add("partner", newConsumer("partner-b", "b", "ns-b")).
+		Add("partner", newConsumer("partner-all", "all", "*")).
+		Add("partner2", newConsumer("partner2-all", "all", "*")).
+		Add("partner2", newConsumer("partner2-b", "all", "ns-b")).
+		Build()
+	UpdateConsumers(v)
+
+	all := []string{"*"}
+	tests := []struct {
+		name       string
+		ns         string
+		importFrom []string
+		key        string
+		consumer   string
+	}{
+		{
+			name:       "local consumer takes precedence",
+			ns:         "ns",
+			importFrom: all,
+			key:        "shared",
+			consumer:   "local",
+		},
+		{
+			name:       "exported to all",
+			ns:         "ns-b",
+			importFrom: all,
+			key:        "shared",
+			consumer:   "partner/partner",
+		},
+		{
+			name: "not imported",
+			ns:   "ns-b",
+			key:  "shared",
+		},
+		{
+			name:       "not imported from the namespace",
+			ns:         "ns-b",
+			importFrom: []string{"partner2"},
+			key:        "shared",
+		},
+		{
+			name:     "consumer is visible in its own namespace",
+			ns:       "partner",
+			key:      "b",
+			consumer: "partner-b",
+		},
+		{
+			name:       "exported to the namespace",
+			ns:         "ns-b",
+			importFrom: []string{"partner"},
+			key:        "b",
+			consumer:   "partner/partner-b",
+		},
+		{
+			name:       "not exported to the namespace",
+			ns:         "ns",
+			importFrom: all,
+			key:        "b",
+		},
+		{
+			name:       "exported to the namespace explicitly takes precedence",
+			ns:         "ns-b",
+			importFrom: all,
+			key:        "all",
+			consumer:   "partner2/partner2-b",
+		},
+		{
+			name:       "the first namespace wins if collision",
+			ns:         "ns",
+			importFrom: all,
+			key:        "all",
+			consumer:   "partner/partner-all",
+		},
+		{
+			name:       "the imported namespace wins if collision",
+			ns:         "ns",
+			importFrom: []string{"partner2"},
+			key:        "all",
+			consumer:   "partner2/partner2-all",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := LookupConsumer(tt.ns, tt.importFrom, "consumerPluginX", tt.key)
+			if tt.consumer == "" {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, tt.consumer, r.Name())
+		})
+	}
+}
@@ -105,3 +105,25 @@ func TestConsumerInitConfigs(t *testing.T) {
 		})
 	}
 }
+
+func TestConsumerMetadata(t *testing.T) {
+	consumer := cmModel.Consumer{
+		Auth: map[string]string{
+			"consumerPluginX": `{"key": "test"}`,
+		},
+		Metadata: map[string]string{
+			"tier": "gold",
+		},
+	}
+
+	var c Consumer
+	err := c.Unmarshal(consumer.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, "gold", c.Metadata()["tier"])
+
+	consumer.Metadata = nil
+	c = Consumer{}
+	err = c.Unmarshal(consumer.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, "", c.Metadata()["tier"])
+}
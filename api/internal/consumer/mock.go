@@ -85,3 +85,7 @@ func (c *MockConsumer) Name() string {
 func (c *MockConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
 	return &ConsumerConfig{}
 }
+
+func (c *MockConsumer) Metadata() map[string]string {
+	return nil
+}
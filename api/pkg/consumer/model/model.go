@@ -20,9 +20,19 @@ import (
 	"mosn.io/htnn/api/pkg/filtermanager/model"
 )
 
+const (
+	// ExportToAllNamespaces is used in the Consumer's ExportTo to make it visible to all namespaces
+	ExportToAllNamespaces = "*"
+	// ImportFromAllNamespaces is used in the route's consumer import config to accept the consumers
+	// exported from all namespaces
+	ImportFromAllNamespaces = "*"
+)
+
 type Consumer struct {
-	Auth    map[string]string              `json:"auth"`
-	Filters map[string]*model.FilterConfig `json:"filters,omitempty"`
+	Auth     map[string]string              `json:"auth"`
+	Filters  map[string]*model.FilterConfig `json:"filters,omitempty"`
+	Metadata map[string]string              `json:"metadata,omitempty"`
+	ExportTo []string                       `json:"exportTo,omitempty"`
 }
 
 func (c *Consumer) Marshal() string {
@@ -22,6 +22,7 @@ import (
 	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"mosn.io/htnn/api/internal/proto"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
@@ -68,6 +69,11 @@ func (p *DynamicConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigCallbac
 	}
 
 	fields := configStruct.Value.GetFields()
+	if manifest := fields["manifest"].GetStructValue(); manifest != nil {
+		deleteDynamicConfigsNotInManifest(manifest)
+		return placeholder, nil
+	}
+
 	name := fields["name"].GetStringValue()
 	cfg := fields["config"]
 	if name == "" || cfg == nil {
@@ -81,29 +87,104 @@ func (p *DynamicConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigCallbac
 		return placeholder, nil
 	}
 
+	namespace := fields["namespace"].GetStringValue()
+	scope := Scope(fields["scope"].GetStringValue())
+	err := applyDynamicConfig(name, namespace, scope, cb, cfg)
+	if target := parseStatusReportTarget(fields["status_report"]); target != nil {
+		reportStatus(target, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return placeholder, nil
+}
+
+func applyDynamicConfig(name string, namespace string, scope Scope, cb DynamicConfigHandler, cfg *structpb.Value) error {
 	conf := cb.Config()
 	data, err := cfg.MarshalJSON()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	api.LogInfof("receive dynamic config %s, configuration: %s", name, data)
+	api.LogInfof("receive dynamic config %s, namespace: %s, scope: %s, configuration: %s", name, namespace, scope, data)
 	err = proto.UnmarshalJSON(data, conf)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	err = conf.Validate()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = cb.OnUpdate(conf)
+	err = cb.OnUpdate(namespace, conf)
 	if err != nil {
-		return nil, err
+		prev := lookupApplied(name, namespace, scope)
+		if prev == nil {
+			return err
+		}
+
+		// Roll back to the last known good config, in case the handler has changed its state
+		// before returning the error
+		api.LogErrorf("failed to apply dynamic config %s, namespace: %s, err: %v, roll back to version %d",
+			name, namespace, err, prev.Version)
+		if rollbackErr := cb.OnUpdate(namespace, prev.Config); rollbackErr != nil {
+			api.LogErrorf("failed to roll back dynamic config %s, namespace: %s, err: %v", name, namespace, rollbackErr)
+		}
+		return fmt.Errorf("%w, keep using the last known good config", err)
 	}
 
-	return placeholder, nil
+	storeDynamicConfig(name, namespace, scope, conf)
+	return nil
+}
+
+func deleteDynamicConfig(name string, namespace string, scope Scope, cb DynamicConfigHandler) {
+	if lookupApplied(name, namespace, scope) == nil {
+		// already deleted, or never applied
+		return
+	}
+
+	api.LogInfof("delete dynamic config %s, namespace: %s, scope: %s", name, namespace, scope)
+	if deleter, ok := cb.(DynamicConfigDeleter); ok {
+		if err := deleter.OnDelete(namespace); err != nil {
+			api.LogErrorf("failed to delete dynamic config %s, namespace: %s, err: %v", name, namespace, err)
+		}
+	}
+	removeDynamicConfig(name, namespace, scope)
+}
+
+// deleteDynamicConfigsNotInManifest deletes the applied configs which are not listed in the manifest.
+// The manifest lists the types of all the existing DynamicConfigs, like:
+//
+//	{"global": ["type"], "namespaces": {"ns": ["type"]}}
+func deleteDynamicConfigsNotInManifest(manifest *structpb.Struct) {
+	listed := func(list *structpb.ListValue, name string) bool {
+		for _, v := range list.GetValues() {
+			if v.GetStringValue() == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	fields := manifest.GetFields()
+	global := fields["global"].GetListValue()
+	namespaces := fields["namespaces"].GetStructValue().GetFields()
+	for name, cb := range dynamicConfigHandlers {
+		snap := loadSnapshot(name)
+		if snap == nil {
+			continue
+		}
+		if snap.global != nil && !listed(global, name) {
+			deleteDynamicConfig(name, snap.global.Namespace, ScopeGlobal, cb)
+		}
+		for ns := range snap.namespaces {
+			if !listed(namespaces[ns].GetListValue(), name) {
+				deleteDynamicConfig(name, ns, ScopeNamespace, cb)
+			}
+		}
+	}
 }
 
 func (p *DynamicConfigParser) Merge(parent interface{}, child interface{}) interface{} {
@@ -122,7 +203,19 @@ type DynamicConfigProvider interface {
 type DynamicConfigHandler interface {
 	DynamicConfigProvider
 
-	OnUpdate(config any) error
+	// OnUpdate is called with the namespace of the DynamicConfig resource when the config is updated.
+	// The same type of DynamicConfig may be configured in different namespaces with different values.
+	// If an error is returned, OnUpdate will be called again with the last known good config
+	// if there is one.
+	OnUpdate(namespace string, config any) error
+}
+
+// DynamicConfigDeleter can be implemented by the DynamicConfigHandler to know when the DynamicConfig
+// resource is deleted.
+type DynamicConfigDeleter interface {
+	// OnDelete is called with the namespace of the deleted DynamicConfig resource. After that,
+	// the config can't be looked up from this namespace.
+	OnDelete(namespace string) error
 }
 
 // We extra RegisterDynamicConfigProvider out of RegisterDynamicConfigHandler, so that
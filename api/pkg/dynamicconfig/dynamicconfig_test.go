@@ -15,10 +15,20 @@
 package dynamicconfig
 
 import (
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	xds "github.com/cncf/xds/go/xds/type/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -84,3 +94,368 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+type reportTestConfig struct {
+	*structpb.Struct
+}
+
+func (c *reportTestConfig) Validate() error {
+	return nil
+}
+
+type reportTestHandler struct {
+}
+
+func (h *reportTestHandler) Config() DynamicConfig {
+	return &reportTestConfig{Struct: &structpb.Struct{}}
+}
+
+func (h *reportTestHandler) OnUpdate(namespace string, c any) error {
+	if _, ok := c.(*reportTestConfig).Fields["fail"]; ok {
+		return errors.New("ouch")
+	}
+	return nil
+}
+
+func TestReportStatus(t *testing.T) {
+	RegisterDynamicConfigHandler("report", &reportTestHandler{})
+	dir := t.TempDir()
+	istioTokenPath = filepath.Join(dir, "istio-token")
+	err := os.WriteFile(istioTokenPath, []byte("token\n"), 0600)
+	require.Nil(t, err)
+
+	reports := make(chan *StatusReport, 1)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		report := &StatusReport{}
+		err := json.NewDecoder(r.Body).Decode(report)
+		assert.Nil(t, err)
+		reports <- report
+	}))
+	defer srv.Close()
+	// the data plane verifies the control plane with the istio root certificate
+	istioRootCertPath = filepath.Join(dir, "root-cert.pem")
+	err = os.WriteFile(istioRootCertPath,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600)
+	require.Nil(t, err)
+
+	newInput := func(config map[string]interface{}) *anypb.Any {
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(map[string]interface{}{
+			"name":   "report",
+			"config": config,
+			"status_report": map[string]interface{}{
+				"url":        srv.URL,
+				"namespace":  "ns",
+				"name":       "dc",
+				"generation": 2,
+			},
+		})
+		return proto.MessageToAny(&ts)
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "programmed",
+			config: map[string]interface{}{},
+		},
+		{
+			name:   "failed",
+			config: map[string]interface{}{"fail": true},
+			err:    "ouch, keep using the last known good config",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser := &DynamicConfigParser{}
+			_, err := parser.Parse(newInput(c.config), nil)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			select {
+			case report := <-reports:
+				assert.Equal(t, &StatusReport{
+					Namespace:  "ns",
+					Name:       "dc",
+					Generation: 2,
+					Error:      c.err,
+				}, report)
+			case <-time.After(3 * time.Second):
+				t.Fatal("status is not reported")
+			}
+		})
+	}
+}
+
+type lookupTestHandler struct {
+	reportTestHandler
+
+	namespaces []string
+}
+
+func (h *lookupTestHandler) OnUpdate(namespace string, c any) error {
+	h.namespaces = append(h.namespaces, namespace)
+	return h.reportTestHandler.OnUpdate(namespace, c)
+}
+
+func TestLookupDynamicConfig(t *testing.T) {
+	h := &lookupTestHandler{}
+	RegisterDynamicConfigHandler("lookup", h)
+
+	newInput := func(namespace string, scope Scope, config map[string]interface{}) *anypb.Any {
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(map[string]interface{}{
+			"name":      "lookup",
+			"namespace": namespace,
+			"scope":     string(scope),
+			"config":    config,
+		})
+		return proto.MessageToAny(&ts)
+	}
+
+	assert.Nil(t, LookupDynamicConfig("lookup", "ns"))
+
+	parser := &DynamicConfigParser{}
+	_, err := parser.Parse(newInput("istio-system", ScopeGlobal, map[string]interface{}{"v": "global"}), nil)
+	assert.Nil(t, err)
+	_, err = parser.Parse(newInput("ns", ScopeNamespace, map[string]interface{}{"v": "ns"}), nil)
+	assert.Nil(t, err)
+	// failed update is not stored
+	_, err = parser.Parse(newInput("ns", ScopeNamespace, map[string]interface{}{"fail": true}), nil)
+	assert.NotNil(t, err)
+	// the failed update is followed by a rollback
+	assert.Equal(t, []string{"istio-system", "ns", "ns", "ns"}, h.namespaces)
+
+	conf := LookupDynamicConfig("lookup", "ns").(*reportTestConfig)
+	assert.Equal(t, "ns", conf.Fields["v"].GetStringValue())
+	conf = LookupDynamicConfig("lookup", "other").(*reportTestConfig)
+	assert.Equal(t, "global", conf.Fields["v"].GetStringValue())
+	assert.Nil(t, LookupDynamicConfig("unknown", "ns"))
+}
+
+func TestGetAndSubscribe(t *testing.T) {
+	RegisterDynamicConfigHandler("typed", &lookupTestHandler{})
+
+	parse := func(namespace string, scope Scope, v string) {
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(map[string]interface{}{
+			"name":      "typed",
+			"namespace": namespace,
+			"scope":     string(scope),
+			"config":    map[string]interface{}{"v": v},
+		})
+		parser := &DynamicConfigParser{}
+		_, err := parser.Parse(proto.MessageToAny(&ts), nil)
+		assert.Nil(t, err)
+	}
+
+	_, _, ok := Get[*reportTestConfig]("typed", "ns")
+	assert.False(t, ok)
+
+	parse("istio-system", ScopeGlobal, "global")
+
+	var updates []Update[*reportTestConfig]
+	unsubscribe := Subscribe("typed", func(u Update[*reportTestConfig]) {
+		updates = append(updates, u)
+	})
+	// the applied config is delivered on subscribing
+	assert.Equal(t, 1, len(updates))
+	assert.Equal(t, ScopeGlobal, updates[0].Scope)
+	assert.Equal(t, uint64(1), updates[0].Version)
+
+	conf, version, ok := Get[*reportTestConfig]("typed", "ns")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), version)
+	assert.Equal(t, "global", conf.Fields["v"].GetStringValue())
+
+	parse("ns", ScopeNamespace, "ns")
+	assert.Equal(t, 2, len(updates))
+	assert.Equal(t, "ns", updates[1].Namespace)
+	assert.Equal(t, uint64(2), updates[1].Version)
+
+	newConf, version, ok := Get[*reportTestConfig]("typed", "ns")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), version)
+	assert.Equal(t, "ns", newConf.Fields["v"].GetStringValue())
+	// the snapshot got before is not changed
+	assert.Equal(t, "global", conf.Fields["v"].GetStringValue())
+
+	// mismatched type
+	type mismatchedConfig struct {
+		reportTestConfig
+	}
+	_, _, ok = Get[*mismatchedConfig]("typed", "ns")
+	assert.False(t, ok)
+
+	panicked := Subscribe("typed", func(u Update[*reportTestConfig]) {
+		panic("oops")
+	})
+	defer panicked()
+
+	unsubscribe()
+	parse("istio-system", ScopeGlobal, "global2")
+	assert.Equal(t, 2, len(updates))
+	conf, version, _ = Get[*reportTestConfig]("typed", "other")
+	assert.Equal(t, uint64(3), version)
+	assert.Equal(t, "global2", conf.Fields["v"].GetStringValue())
+}
+
+func TestSubscribeInCallback(t *testing.T) {
+	storeDynamicConfig("nested", "istio-system", ScopeGlobal, &reportTestConfig{})
+
+	var inner []uint64
+	var unsubscribeInner func()
+	unsubscribe := Subscribe("nested", func(u Update[*reportTestConfig]) {
+		if unsubscribeInner == nil {
+			// subscribing during the replay should not deadlock
+			unsubscribeInner = Subscribe("nested", func(u Update[*reportTestConfig]) {
+				inner = append(inner, u.Version)
+			})
+		}
+	})
+	defer unsubscribe()
+	defer unsubscribeInner()
+
+	storeDynamicConfig("nested", "ns", ScopeNamespace, &reportTestConfig{})
+	assert.Equal(t, []uint64{1, 2}, inner)
+}
+
+func TestSubscribeConcurrently(t *testing.T) {
+	const updates = 200
+	namespaces := []string{"ns1", "ns2"}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < updates; i++ {
+			ns := namespaces[i%len(namespaces)]
+			if i%7 == 0 {
+				removeDynamicConfig("concurrent", ns, ScopeNamespace)
+			} else {
+				storeDynamicConfig("concurrent", ns, ScopeNamespace, &reportTestConfig{})
+			}
+		}
+	}()
+
+	type result struct {
+		lock     sync.Mutex
+		versions map[string][]uint64
+	}
+	var results []*result
+	for i := 0; i < 20; i++ {
+		r := &result{versions: map[string][]uint64{}}
+		results = append(results, r)
+		unsubscribe := Subscribe("concurrent", func(u Update[*reportTestConfig]) {
+			r.lock.Lock()
+			r.versions[u.Namespace] = append(r.versions[u.Namespace], u.Version)
+			r.lock.Unlock()
+		})
+		defer unsubscribe()
+	}
+	<-done
+
+	for _, r := range results {
+		r.lock.Lock()
+		for ns, versions := range r.versions {
+			// delivered once and in order
+			for i := 1; i < len(versions); i++ {
+				assert.Less(t, versions[i-1], versions[i], ns)
+			}
+		}
+		r.lock.Unlock()
+	}
+}
+
+type deleteTestHandler struct {
+	reportTestHandler
+
+	applied map[string]string
+	deleted []string
+}
+
+func (h *deleteTestHandler) OnUpdate(namespace string, c any) error {
+	v := c.(*reportTestConfig).Fields["v"].GetStringValue()
+	// change the state before returning the error
+	h.applied[namespace] = v
+	return h.reportTestHandler.OnUpdate(namespace, c)
+}
+
+func (h *deleteTestHandler) OnDelete(namespace string) error {
+	delete(h.applied, namespace)
+	h.deleted = append(h.deleted, namespace)
+	return nil
+}
+
+func TestRollbackAndDelete(t *testing.T) {
+	h := &deleteTestHandler{applied: map[string]string{}}
+	RegisterDynamicConfigHandler("delete", h)
+
+	parser := &DynamicConfigParser{}
+	parse := func(fields map[string]interface{}) error {
+		fields["name"] = "delete"
+		fields["namespace"] = "ns"
+		fields["scope"] = string(ScopeNamespace)
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(fields)
+		_, err := parser.Parse(proto.MessageToAny(&ts), nil)
+		return err
+	}
+
+	var updates []Update[*reportTestConfig]
+	unsubscribe := Subscribe("delete", func(u Update[*reportTestConfig]) {
+		updates = append(updates, u)
+	})
+	defer unsubscribe()
+
+	// a failed update without the last known good config
+	err := parse(map[string]interface{}{"config": map[string]interface{}{"v": "bad", "fail": true}})
+	assert.Equal(t, "ouch", err.Error())
+	assert.Nil(t, LookupDynamicConfig("delete", "ns"))
+
+	err = parse(map[string]interface{}{"config": map[string]interface{}{"v": "good"}})
+	assert.Nil(t, err)
+	err = parse(map[string]interface{}{"config": map[string]interface{}{"v": "bad", "fail": true}})
+	assert.ErrorContains(t, err, "keep using the last known good config")
+	assert.Equal(t, "good", h.applied["ns"])
+	conf, _, _ := Get[*reportTestConfig]("delete", "ns")
+	assert.Equal(t, "good", conf.Fields["v"].GetStringValue())
+
+	parseManifest := func(manifest map[string]interface{}) {
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(map[string]interface{}{"manifest": manifest})
+		_, err := parser.Parse(proto.MessageToAny(&ts), nil)
+		assert.Nil(t, err)
+	}
+	// listed in the manifest
+	parseManifest(map[string]interface{}{
+		"namespaces": map[string]interface{}{"ns": []interface{}{"delete"}},
+	})
+	assert.Equal(t, 0, len(h.deleted))
+	assert.Equal(t, "good", h.applied["ns"])
+
+	// the global one with the same type doesn't keep the namespaced one
+	parseManifest(map[string]interface{}{
+		"global": []interface{}{"delete"},
+	})
+	assert.Equal(t, []string{"ns"}, h.deleted)
+	assert.Equal(t, 0, len(h.applied))
+	assert.Nil(t, LookupDynamicConfig("delete", "ns"))
+
+	// the manifest may be delivered again
+	parseManifest(map[string]interface{}{})
+	assert.Equal(t, []string{"ns"}, h.deleted)
+
+	assert.Equal(t, 2, len(updates))
+	assert.False(t, updates[0].Deleted)
+	assert.True(t, updates[1].Deleted)
+	assert.Nil(t, updates[1].Config)
+	assert.Equal(t, updates[0].Version+1, updates[1].Version)
+}
@@ -0,0 +1,139 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// StatusReport is the result of applying a dynamic config in the data plane. It's sent to the
+// control plane so that the control plane can surface it in the status of the DynamicConfig.
+// The control plane identifies the data plane with its istio token, so the report doesn't
+// carry the identity.
+type StatusReport struct {
+	// Namespace, Name and Generation identify the DynamicConfig resource in the control plane
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation"`
+	// Error is empty if the dynamic config is applied successfully
+	Error string `json:"error,omitempty"`
+}
+
+// statusReportTarget is set by the control plane in the dynamic config, to tell the data plane
+// where to report the status.
+type statusReportTarget struct {
+	URL        string
+	Namespace  string
+	Name       string
+	Generation int64
+}
+
+var (
+	// The token which istio-proxy uses to authenticate itself to istiod. It is rotated by the
+	// kubelet, so we read it each time.
+	istioTokenPath = "/var/run/secrets/tokens/istio-token"
+	// The root certificate used to verify istiod when the report URL is HTTPS
+	istioRootCertPath = "/var/run/secrets/istio/root-cert.pem"
+)
+
+func parseStatusReportTarget(v *structpb.Value) *statusReportTarget {
+	fields := v.GetStructValue().GetFields()
+	url := fields["url"].GetStringValue()
+	if url == "" {
+		return nil
+	}
+	return &statusReportTarget{
+		URL:        url,
+		Namespace:  fields["namespace"].GetStringValue(),
+		Name:       fields["name"].GetStringValue(),
+		Generation: int64(fields["generation"].GetNumberValue()),
+	}
+}
+
+func newStatusReport(target *statusReportTarget, err error) *StatusReport {
+	report := &StatusReport{
+		Namespace:  target.Namespace,
+		Name:       target.Name,
+		Generation: target.Generation,
+	}
+	if err != nil {
+		report.Error = err.Error()
+	}
+	return report
+}
+
+func newStatusReportClient() *http.Client {
+	client := &http.Client{Timeout: 5 * time.Second}
+	pem, err := os.ReadFile(istioRootCertPath)
+	if err != nil {
+		return client
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		api.LogErrorf("invalid root certificate in %s", istioRootCertPath)
+		return client
+	}
+	client.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	return client
+}
+
+func reportStatus(target *statusReportTarget, err error) {
+	report := newStatusReport(target, err)
+	data, _ := json.Marshal(report)
+	// Don't block the parsing of the configuration
+	go func() {
+		req, err := http.NewRequest(http.MethodPost, target.URL, bytes.NewReader(data))
+		if err != nil {
+			api.LogErrorf("failed to report status of dynamic config %s/%s: %v", target.Namespace, target.Name, err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		token, err := os.ReadFile(istioTokenPath)
+		if err != nil {
+			// let the control plane decide whether to accept the report, for example, when
+			// the data plane is authenticated via other ways
+			api.LogWarnf("failed to read istio token for reporting status of dynamic config %s/%s: %v",
+				target.Namespace, target.Name, err)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+		}
+		resp, err := newStatusReportClient().Do(req)
+		if err != nil {
+			api.LogErrorf("failed to report status of dynamic config %s/%s: %v", target.Namespace, target.Name, err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			api.LogErrorf("failed to report status of dynamic config %s/%s, status code: %d",
+				target.Namespace, target.Name, resp.StatusCode)
+		}
+	}()
+}
@@ -0,0 +1,302 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamicconfig
+
+import (
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// Scope describes which data planes the DynamicConfig applies to
+type Scope string
+
+const (
+	// ScopeNamespace means the DynamicConfig only applies to the data planes in the same namespace
+	ScopeNamespace Scope = "namespace"
+	// ScopeGlobal means the DynamicConfig is configured in the root namespace and applies to all the data planes
+	ScopeGlobal Scope = "global"
+)
+
+// Update describes an applied DynamicConfig
+type Update[T any] struct {
+	Namespace string
+	Scope     Scope
+	Config    T
+	// Version increases monotonically each time a DynamicConfig with the same name is applied or deleted
+	Version uint64
+	// Deleted is true if the DynamicConfig is deleted. The Config is empty in this case.
+	Deleted bool
+}
+
+type entry = Update[DynamicConfig]
+
+// snapshot is immutable once it is published. Each update creates a new snapshot so that the
+// readers can get a consistent view without locking.
+type snapshot struct {
+	global     *entry
+	namespaces map[string]*entry
+}
+
+type dynamicConfigStore struct {
+	current atomic.Pointer[snapshot]
+	version uint64
+
+	subscribersLock sync.RWMutex
+	subscribers     []*subscriber
+}
+
+type entryKey struct {
+	namespace string
+	scope     Scope
+}
+
+type subscriber struct {
+	name string
+	cb   func(*entry)
+
+	// lock serializes the delivery, so that the replayed configs and the updates are delivered in order
+	lock sync.Mutex
+	// the version of the last delivered update for each namespace and scope
+	delivered map[entryKey]uint64
+}
+
+// deliver calls the callback unless a newer or the same update of the namespace and scope has been delivered
+func (s *subscriber) deliver(e *entry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := entryKey{namespace: e.Namespace, scope: e.Scope}
+	if e.Scope == ScopeGlobal {
+		// the global config has only one instance, whatever the namespace is
+		key.namespace = ""
+	}
+	if s.delivered[key] >= e.Version {
+		return
+	}
+	s.delivered[key] = e.Version
+	notify(s.name, s.cb, e)
+}
+
+var (
+	storesLock sync.Mutex
+	// name => the applied configs
+	stores sync.Map
+)
+
+func loadStore(name string) *dynamicConfigStore {
+	if s, ok := stores.Load(name); ok {
+		return s.(*dynamicConfigStore)
+	}
+
+	storesLock.Lock()
+	defer storesLock.Unlock()
+	s, _ := stores.LoadOrStore(name, &dynamicConfigStore{})
+	return s.(*dynamicConfigStore)
+}
+
+func storeDynamicConfig(name string, namespace string, scope Scope, conf DynamicConfig) {
+	updateStore(name, &entry{
+		Namespace: namespace,
+		Scope:     scope,
+		Config:    conf,
+	})
+}
+
+func removeDynamicConfig(name string, namespace string, scope Scope) {
+	updateStore(name, &entry{
+		Namespace: namespace,
+		Scope:     scope,
+		Deleted:   true,
+	})
+}
+
+func updateStore(name string, e *entry) {
+	store := loadStore(name)
+
+	storesLock.Lock()
+	store.version++
+	e.Version = store.version
+
+	prev := store.current.Load()
+	next := &snapshot{
+		namespaces: map[string]*entry{},
+	}
+	if prev != nil {
+		next.global = prev.global
+		for ns, e := range prev.namespaces {
+			next.namespaces[ns] = e
+		}
+	}
+	if e.Scope == ScopeGlobal {
+		next.global = e
+		if e.Deleted {
+			next.global = nil
+		}
+	} else {
+		next.namespaces[e.Namespace] = e
+		if e.Deleted {
+			delete(next.namespaces, e.Namespace)
+		}
+	}
+	store.current.Store(next)
+	storesLock.Unlock()
+
+	store.subscribersLock.RLock()
+	subscribers := store.subscribers
+	store.subscribersLock.RUnlock()
+	for _, sub := range subscribers {
+		sub.deliver(e)
+	}
+}
+
+func notify(name string, cb func(*entry), e *entry) {
+	defer func() {
+		if p := recover(); p != nil {
+			api.LogErrorf("panic in subscriber of dynamic config %s: %v\n%s", name, p, debug.Stack())
+		}
+	}()
+	cb(e)
+}
+
+func loadSnapshot(name string) *snapshot {
+	s, ok := stores.Load(name)
+	if !ok {
+		return nil
+	}
+	return s.(*dynamicConfigStore).current.Load()
+}
+
+func lookup(name string, namespace string) *entry {
+	snap := loadSnapshot(name)
+	if snap == nil {
+		return nil
+	}
+	if e, ok := snap.namespaces[namespace]; ok {
+		return e
+	}
+	return snap.global
+}
+
+// lookupApplied returns the config applied with the given namespace and scope, without falling back
+// to the global one
+func lookupApplied(name string, namespace string, scope Scope) *entry {
+	snap := loadSnapshot(name)
+	if snap == nil {
+		return nil
+	}
+	if scope == ScopeGlobal {
+		return snap.global
+	}
+	return snap.namespaces[namespace]
+}
+
+// LookupDynamicConfig returns the DynamicConfig which applies to the given namespace. The one
+// configured in the same namespace takes precedence over the global one. Returns nil if no
+// DynamicConfig is found. The returned config should be treated as read-only.
+func LookupDynamicConfig(name string, namespace string) DynamicConfig {
+	e := lookup(name, namespace)
+	if e == nil {
+		return nil
+	}
+	return e.Config
+}
+
+// Get returns the DynamicConfig which applies to the given namespace, with its version. Like
+// LookupDynamicConfig, the one configured in the same namespace takes precedence over the global one.
+// The returned config is a snapshot which won't be changed by the later updates, and should be
+// treated as read-only. Returns false if no DynamicConfig is found or the config is not a T.
+func Get[T DynamicConfig](name string, namespace string) (config T, version uint64, ok bool) {
+	e := lookup(name, namespace)
+	if e == nil {
+		return config, 0, false
+	}
+	config, ok = e.Config.(T)
+	if !ok {
+		api.LogErrorf("dynamic config %s is %T, not the expected type", name, e.Config)
+		return config, 0, false
+	}
+	return config, e.Version, true
+}
+
+// Subscribe registers a callback which is called each time a DynamicConfig with the given name
+// is applied or deleted. The callback is also called with the configs which are applied before subscribing.
+// Each update is delivered once, and the updates of the same namespace and scope are delivered in order.
+// The callback runs in the thread which parses the configuration, so it should return quickly.
+// Call the returned function to unsubscribe.
+func Subscribe[T DynamicConfig](name string, cb func(update Update[T])) (unsubscribe func()) {
+	wrapper := func(e *entry) {
+		var config T
+		if !e.Deleted {
+			var ok bool
+			config, ok = e.Config.(T)
+			if !ok {
+				api.LogErrorf("dynamic config %s is %T, not the expected type", name, e.Config)
+				return
+			}
+		}
+		cb(Update[T]{
+			Namespace: e.Namespace,
+			Scope:     e.Scope,
+			Config:    config,
+			Version:   e.Version,
+			Deleted:   e.Deleted,
+		})
+	}
+	sub := &subscriber{
+		name:      name,
+		cb:        wrapper,
+		delivered: map[entryKey]uint64{},
+	}
+
+	store := loadStore(name)
+	// Take the snapshot and register the subscriber atomically, so that no update is missed.
+	// The configs in the snapshot are delivered without holding the lock, so the callback can
+	// subscribe again. An update which is both in the snapshot and notified is deduplicated by
+	// its version.
+	storesLock.Lock()
+	snap := store.current.Load()
+	store.subscribersLock.Lock()
+	// copy on write, so that the notifying doesn't need to hold the lock
+	subscribers := make([]*subscriber, 0, len(store.subscribers)+1)
+	subscribers = append(subscribers, store.subscribers...)
+	store.subscribers = append(subscribers, sub)
+	store.subscribersLock.Unlock()
+	storesLock.Unlock()
+
+	if snap != nil {
+		if snap.global != nil {
+			sub.deliver(snap.global)
+		}
+		for _, e := range snap.namespaces {
+			sub.deliver(e)
+		}
+	}
+
+	return func() {
+		store.subscribersLock.Lock()
+		defer store.subscribersLock.Unlock()
+
+		subscribers := make([]*subscriber, 0, len(store.subscribers))
+		for _, s := range store.subscribers {
+			if s != sub {
+				subscribers = append(subscribers, s)
+			}
+		}
+		store.subscribers = subscribers
+	}
+}
@@ -193,6 +193,8 @@ type PluginConsumerConfig interface {
 type Consumer interface {
 	Name() string
 	PluginConfig(name string) PluginConsumerConfig
+	// Metadata returns the key/value pairs attached to the consumer. The returned map is read-only.
+	Metadata() map[string]string
 }
 
 // StreamFilterCallbacks provides API that is used during request processing
@@ -226,6 +228,10 @@ type StreamFilterCallbacks interface {
 	SetConsumer(c Consumer)
 	GetConsumer() Consumer
 
+	// Namespace returns the namespace of the current route. It can be used to look up the
+	// namespaced resources, like the DynamicConfig.
+	Namespace() string
+
 	// PluginState returns the PluginState associated to this request.
 	PluginState() PluginState
 
@@ -143,9 +143,10 @@ type filterManagerCallbackHandler struct {
 
 	cacheLock sync.Mutex
 
-	namespace   string
-	consumer    api.Consumer
-	pluginState api.PluginState
+	namespace           string
+	importConsumersFrom []string
+	consumer            api.Consumer
+	pluginState         api.PluginState
 
 	streamInfo *filterManagerStreamInfo
 
@@ -157,8 +158,8 @@ func (cb *filterManagerCallbackHandler) Reset() {
 	cb.cacheLock.Lock()
 
 	cb.FilterCallbackHandler = nil
-	// We don't reset namespace, as filterManager will only be reused in the same route,
-	// which must have the same namespace.
+	// We don't reset namespace and importConsumersFrom, as filterManager will only be reused in the same route,
+	// which must have the same namespace and configuration.
 	cb.consumer = nil
 	cb.pluginState = nil
 	cb.streamInfo = nil
@@ -182,7 +183,7 @@ func (cb *filterManagerCallbackHandler) StreamInfo() api.StreamInfo {
 // Consumer getter/setter should only be called in DecodeHeaders
 
 func (cb *filterManagerCallbackHandler) LookupConsumer(pluginName, key string) (api.Consumer, bool) {
-	return consumer.LookupConsumer(cb.namespace, pluginName, key)
+	return consumer.LookupConsumer(cb.namespace, cb.importConsumersFrom, pluginName, key)
 }
 
 func (cb *filterManagerCallbackHandler) GetConsumer() api.Consumer {
@@ -198,6 +199,10 @@ func (cb *filterManagerCallbackHandler) SetConsumer(c api.Consumer) {
 	cb.consumer = c
 }
 
+func (cb *filterManagerCallbackHandler) Namespace() string {
+	return cb.namespace
+}
+
 func (cb *filterManagerCallbackHandler) PluginState() api.PluginState {
 	cb.cacheLock.Lock()
 	if cb.pluginState == nil {
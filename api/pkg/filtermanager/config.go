@@ -26,6 +26,7 @@ import (
 	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"mosn.io/htnn/api/internal/consumer"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/filtermanager/model"
 	pkgPlugins "mosn.io/htnn/api/pkg/plugins"
@@ -60,6 +61,19 @@ type filterManagerConfig struct {
 	namespace string
 
 	enableDebugMode bool
+
+	authnCompositionMode pkgPlugins.AuthnCompositionMode
+
+	// the namespaces whose exported consumers are accepted
+	importConsumersFrom []string
+
+	// consumer's namespaced name => the consumer's filter configs merged with the route's
+	consumerFilterConfigs sync.Map
+}
+
+type mergedConsumerFilterConfigs struct {
+	consumer *consumer.Consumer
+	configs  map[string]*model.ParsedFilterConfig
 }
 
 func initFilterManagerConfig(namespace string) *filterManagerConfig {
@@ -69,7 +83,8 @@ func initFilterManagerConfig(namespace string) *filterManagerConfig {
 	config.pool = &sync.Pool{
 		New: func() any {
 			callbacks := &filterManagerCallbackHandler{
-				namespace: namespace,
+				namespace:           namespace,
+				importConsumersFrom: config.importConsumersFrom,
 			}
 			fm := &filterManager{
 				callbacks: callbacks,
@@ -106,6 +121,16 @@ func (conf *filterManagerConfig) Merge(another *filterManagerConfig) *filterMana
 		cp.enableDebugMode = true
 	}
 
+	cp.authnCompositionMode = conf.authnCompositionMode
+	if cp.authnCompositionMode == pkgPlugins.AuthnCompositionModeNone {
+		cp.authnCompositionMode = another.authnCompositionMode
+	}
+
+	cp.importConsumersFrom = conf.importConsumersFrom
+	if cp.importConsumersFrom == nil {
+		cp.importConsumersFrom = another.importConsumersFrom
+	}
+
 	cp.parsed = make([]*model.ParsedFilterConfig, 0, len(conf.parsed)+len(another.parsed))
 	// For now, we don't deepcopy the config. The config may contain connection to the external
 	// service, for example, a Redis cluster. Not sure if it is safe to deepcopy them. So far,
@@ -135,12 +160,11 @@ func (conf *filterManagerConfig) Merge(another *filterManagerConfig) *filterMana
 	})
 
 	// recompute fields which will be different after merging
-	cp.consumerFiltersEndAt = len(cp.parsed)
+	cp.consumerFiltersEndAt = 0
 	for i, fc := range cp.parsed {
 		_, ok := pkgPlugins.LoadPlugin(fc.Name).(pkgPlugins.ConsumerPlugin)
-		if !ok {
-			cp.consumerFiltersEndAt = i
-			break
+		if ok {
+			cp.consumerFiltersEndAt = i + 1
 		}
 	}
 
@@ -153,6 +177,72 @@ func (conf *filterManagerConfig) Merge(another *filterManagerConfig) *filterMana
 	return cp
 }
 
+// mergeConsumerFilterConfigs merges the filter configs from the consumer with the configs of the
+// same plugins in the route, via the plugin's Merge method. The result is cached per consumer.
+// Only the configs of the latest consumer object are cached, so the configs merged for an updated
+// consumer are released.
+func (conf *filterManagerConfig) mergeConsumerFilterConfigs(c *consumer.Consumer) map[string]*model.ParsedFilterConfig {
+	key := c.NamespacedName()
+	if v, ok := conf.consumerFilterConfigs.Load(key); ok {
+		cached := v.(*mergedConsumerFilterConfigs)
+		if cached.consumer == c {
+			return cached.configs
+		}
+	}
+
+	merged := make(map[string]*model.ParsedFilterConfig, len(c.FilterConfigs))
+	for name, fc := range c.FilterConfigs {
+		merged[name] = fc
+		if fc.ParsedConfig == nil {
+			continue
+		}
+
+		var routeFc *model.ParsedFilterConfig
+		for _, cfg := range conf.parsed {
+			if cfg.Name == name {
+				routeFc = cfg
+				break
+			}
+		}
+		if routeFc == nil || routeFc.ParsedConfig == nil {
+			continue
+		}
+
+		plugin := pkgPlugins.LoadHTTPFilterFactoryAndParser(name)
+		if plugin == nil {
+			continue
+		}
+		config := plugin.ConfigParser.Merge(routeFc.ParsedConfig, fc.ParsedConfig)
+		if config == fc.ParsedConfig {
+			// the consumer's config overrides the route's one
+			continue
+		}
+
+		api.LogDebugf("merge plugin %s from consumer %s, config: %+v", name, c.Name(), config)
+		mergedFc := &model.ParsedFilterConfig{
+			Name:          name,
+			ParsedConfig:  config,
+			Factory:       fc.Factory,
+			SyncRunPhases: fc.SyncRunPhases,
+		}
+		if initer, ok := config.(pkgPlugins.Initer); ok {
+			// For now, we have nothing to provide as config callbacks
+			err := initer.Init(nil)
+			if err != nil {
+				mergedFc.Factory = NewInternalErrorFactory(name, err)
+			}
+		}
+		merged[name] = mergedFc
+	}
+
+	// Replace the configs merged for the previous version of the consumer, if any
+	conf.consumerFilterConfigs.Store(key, &mergedConsumerFilterConfigs{
+		consumer: c,
+		configs:  merged,
+	})
+	return merged
+}
+
 func (conf *filterManagerConfig) InitOnce() {
 	if conf.initOnce == nil {
 		return
@@ -252,6 +342,14 @@ func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigC
 					// executing this plugin.
 					conf.enableDebugMode = true
 				}
+
+				if composer, ok := config.(pkgPlugins.AuthnComposer); ok {
+					conf.authnCompositionMode = composer.AuthnCompositionMode()
+				}
+
+				if importer, ok := config.(pkgPlugins.ConsumerImporter); ok {
+					conf.importConsumersFrom = importer.ImportConsumersFrom()
+				}
 			}
 			i++
 
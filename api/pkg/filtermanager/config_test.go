@@ -23,6 +23,8 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"mosn.io/htnn/api/internal/proto"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+	pkgPlugins "mosn.io/htnn/api/pkg/plugins"
 )
 
 func TestParse(t *testing.T) {
@@ -81,3 +83,51 @@ func TestMergeDebugFlag(t *testing.T) {
 	merged = parent.Merge(child)
 	assert.Equal(t, true, merged.enableDebugMode)
 }
+
+func TestMergeAuthnCompositionMode(t *testing.T) {
+	parent := initFilterManagerConfig("")
+	parent.authnCompositionMode = pkgPlugins.AuthnCompositionModeAllOf
+	child := initFilterManagerConfig("")
+	merged := child.Merge(parent)
+	assert.Equal(t, pkgPlugins.AuthnCompositionModeAllOf, merged.authnCompositionMode)
+
+	child.authnCompositionMode = pkgPlugins.AuthnCompositionModeAnyOf
+	merged = child.Merge(parent)
+	assert.Equal(t, pkgPlugins.AuthnCompositionModeAnyOf, merged.authnCompositionMode)
+}
+
+func TestMergeImportConsumersFrom(t *testing.T) {
+	parent := initFilterManagerConfig("ns")
+	parent.importConsumersFrom = []string{"*"}
+	child := initFilterManagerConfig("ns")
+	merged := child.Merge(parent)
+	assert.Equal(t, []string{"*"}, merged.importConsumersFrom)
+
+	child.importConsumersFrom = []string{"partner"}
+	merged = child.Merge(parent)
+	assert.Equal(t, []string{"partner"}, merged.importConsumersFrom)
+
+	// the namespaces are passed to the consumer lookup
+	fm := merged.pool.Get().(*filterManager)
+	assert.Equal(t, []string{"partner"}, fm.callbacks.importConsumersFrom)
+}
+
+func TestMergeConsumerFiltersEndAt(t *testing.T) {
+	pkgPlugins.RegisterPlugin("merge_authn", &pkgPlugins.MockConsumerPlugin{})
+
+	parent := initFilterManagerConfig("")
+	parent.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "merge_authn",
+		},
+	}
+	child := initFilterManagerConfig("")
+	child.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "merge_not_authn",
+		},
+	}
+	merged := child.Merge(parent)
+	assert.Equal(t, 2, len(merged.parsed))
+	assert.Equal(t, 1, merged.consumerFiltersEndAt)
+}
@@ -191,12 +191,12 @@ func FilterManagerFactory(c interface{}, cb capi.FilterCallbackHandler) (streamF
 					api.LogErrorf("plugin %s has DecodeRequest but not DecodeHeaders. To run DecodeRequest, we need to return api.WaitAllData from DecodeHeaders", fc.Name)
 				}
 
-				if conf.consumerFiltersEndAt != 0 {
+				if conf.consumerFiltersEndAt != 0 && conf.authnCompositionMode != pkgPlugins.AuthnCompositionModeNone {
 					p := pkgPlugins.LoadPluginType(fc.Name)
 					if p != nil {
 						order := p.Order()
 						if order.Position <= pkgPlugins.OrderPositionAuthn {
-							api.LogErrorf("plugin %s has DecodeRequest which is not supported because the order of plugin", fc.Name)
+							api.LogErrorf("plugin %s has DecodeRequest which is only run for requests without body in the authn composition mode", fc.Name)
 						}
 					}
 				}
@@ -397,130 +397,36 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 	}
 	m.hdrLock.Unlock()
 	if m.config.consumerFiltersEndAt != 0 {
-		for i := 0; i < m.config.consumerFiltersEndAt; i++ {
-			f := m.filters[i]
-			// We don't support DecodeRequest for now
-			res = f.DecodeHeaders(m.reqHdr, endStream)
-			if m.handleAction(res, api.PhaseDecodeHeaders, f) {
+		if m.config.authnCompositionMode != pkgPlugins.AuthnCompositionModeNone {
+			if m.runComposedAuthnFilters(endStream) {
 				return capi.LocalReply
 			}
-		}
-
-		// we check consumer at the end of authn filters, so we can have multiple authn filters
-		// configured and the consumer will be set by any of them
-		c, ok := m.callbacks.consumer.(*consumer.Consumer)
-		if ok && len(c.FilterConfigs) > 0 {
-			api.LogDebugf("merge filters from consumer: %s", c.Name())
-
-			c.InitOnce.Do(func() {
-				names := make([]string, 0, len(c.FilterConfigs))
-				for name, fc := range c.FilterConfigs {
-					names = append(names, name)
-
-					config := fc.ParsedConfig
-					if initer, ok := config.(pkgPlugins.Initer); ok {
-						// For now, we have nothing to provide as config callbacks
-						err := initer.Init(nil)
-						if err != nil {
-							fc.Factory = NewInternalErrorFactory(fc.Name, err)
-						}
-					}
+		} else {
+			for i := 0; i < m.config.consumerFiltersEndAt; i++ {
+				f := m.filters[i]
+				res = f.DecodeHeaders(m.reqHdr, endStream)
+				if m.handleAction(res, api.PhaseDecodeHeaders, f) {
+					return capi.LocalReply
 				}
 
-				c.FilterNames = names
-			})
-
-			filterWrappers := make([]*model.FilterWrapper, len(c.FilterConfigs))
-			for i, name := range c.FilterNames {
-				fc := c.FilterConfigs[name]
-				factory := fc.Factory
-				config := fc.ParsedConfig
-				f := factory(config, m.callbacks)
-				filterWrappers[i] = model.NewFilterWrapper(name, f)
-			}
-
-			c.CanSkipMethodsOnce.Do(func() {
-				canSkipMethods := api.NewAllMethodsMap()
-				canSyncRunMethods := api.NewAllMethodsMap()
-				for _, fw := range filterWrappers {
-					f := fw.Filter
-					fc := c.FilterConfigs[fw.Name]
-					for meth := range canSkipMethods {
-						overridden, err := reflectx.IsMethodOverridden(f, meth)
-						if err != nil {
-							api.LogErrorf("failed to check method %s in filter: %v", meth, err)
-							// canSkipMethods[meth] will be false
-						}
-						canSkipMethods[meth] = canSkipMethods[meth] && !overridden
-
-						if overridden {
-							canSyncRunMethods[meth] = canSyncRunMethods[meth] && fc.SyncRunPhases.Contains(api.MethodToPhase(meth))
-						}
+				if m.decodeRequestNeeded {
+					m.decodeRequestNeeded = false
+					if !endStream {
+						m.decodeIdx = i
+						// the filters from consumer will be merged after the whole body is
+						// processed by the authn filters
+						return capi.StopAndBuffer
 					}
-				}
-				c.CanSkipMethods = canSkipMethods
-				c.CanSyncRunMethod = canSyncRunMethods
-			})
-
-			if needLogExecution() {
-				for _, fw := range filterWrappers {
-					f := fw.Filter
-					fw.Filter = NewLogExecutionFilter(fw.Name, f, m.callbacks)
-				}
-			}
 
-			if m.DebugModeEnabled() {
-				for _, fw := range filterWrappers {
-					f := fw.Filter
-					fw.Filter = NewDebugFilter(fw.Name, f, m.callbacks)
-				}
-			}
-
-			canSkipMethods := c.CanSkipMethods
-			m.canSkipDecodeData = m.canSkipDecodeData && canSkipMethods["DecodeData"] && canSkipMethods["DecodeRequest"]
-			m.canSkipDecodeTrailers = m.canSkipDecodeTrailers && canSkipMethods["DecodeTrailers"] && canSkipMethods["DecodeRequest"]
-			m.canSkipEncodeHeaders = m.canSkipEncodeData && canSkipMethods["EncodeHeaders"]
-			m.canSkipEncodeData = m.canSkipEncodeData && canSkipMethods["EncodeData"] && canSkipMethods["EncodeResponse"]
-			m.canSkipEncodeTrailers = m.canSkipEncodeTrailers && canSkipMethods["EncodeTrailers"] && canSkipMethods["EncodeResponse"]
-			m.canSkipOnLog = m.canSkipOnLog && canSkipMethods["OnLog"]
-
-			canSyncRunMethods := c.CanSyncRunMethod
-			m.canSyncRunDecodeHeaders = m.canSyncRunDecodeHeaders && canSyncRunMethods["DecodeHeaders"] && canSyncRunMethods["DecodeRequest"]
-			m.canSyncRunDecodeData = m.canSyncRunDecodeData && canSyncRunMethods["DecodeData"]
-			m.canSyncRunDecodeTrailers = m.canSyncRunDecodeTrailers && canSyncRunMethods["DecodeTrailers"]
-			m.canSyncRunEncodeHeaders = m.canSyncRunEncodeHeaders && canSyncRunMethods["EncodeHeaders"] && canSyncRunMethods["EncodeResponse"]
-			m.canSyncRunEncodeData = m.canSyncRunEncodeData && canSyncRunMethods["EncodeData"]
-			m.canSyncRunEncodeTrailers = m.canSyncRunEncodeTrailers && canSyncRunMethods["EncodeTrailers"]
-
-			// TODO: add field to control if merging is allowed
-			i := 0
-			for _, f := range m.filters {
-				if c.FilterConfigs[f.Name] == nil {
-					m.filters[i] = f
-					i++
-				}
-			}
-			m.filters = append(m.filters[:i], filterWrappers...)
-			sort.Slice(m.filters, func(i, j int) bool {
-				return pkgPlugins.ComparePluginOrder(m.filters[i].Name, m.filters[j].Name)
-			})
-
-			if api.GetLogLevel() <= api.LogLevelDebug {
-				for _, f := range m.filters {
-					fc := c.FilterConfigs[f.Name]
-					if fc == nil {
-						// the plugin is not from consumer
-						for _, cfg := range m.config.parsed {
-							if cfg.Name == f.Name {
-								fc = cfg
-								break
-							}
-						}
+					res = f.DecodeRequest(m.reqHdr, nil, nil)
+					if m.handleAction(res, api.PhaseDecodeRequest, f) {
+						return capi.LocalReply
 					}
-					api.LogDebugf("after merged consumer, plugin: %s, config: %+v", f.Name, fc.ParsedConfig)
 				}
 			}
 		}
+
+		m.mergeConsumerFilters()
 	}
 
 	for i := m.config.consumerFiltersEndAt; i < len(m.filters); i++ {
@@ -550,6 +456,228 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 	return capi.Continue
 }
 
+// mergeConsumerFilters merges the filters from the consumer. We check consumer at the end of authn
+// filters, so we can have multiple authn filters configured and the consumer will be set by any of them.
+func (m *filterManager) mergeConsumerFilters() {
+	c, ok := m.callbacks.consumer.(*consumer.Consumer)
+	if ok && len(c.FilterConfigs) > 0 {
+		api.LogDebugf("merge filters from consumer: %s", c.Name())
+
+		c.InitOnce.Do(func() {
+			names := make([]string, 0, len(c.FilterConfigs))
+			for name, fc := range c.FilterConfigs {
+				names = append(names, name)
+
+				config := fc.ParsedConfig
+				if initer, ok := config.(pkgPlugins.Initer); ok {
+					// For now, we have nothing to provide as config callbacks
+					err := initer.Init(nil)
+					if err != nil {
+						fc.Factory = NewInternalErrorFactory(fc.Name, err)
+					}
+				}
+			}
+
+			c.FilterNames = names
+		})
+
+		filterConfigs := m.config.mergeConsumerFilterConfigs(c)
+		filterWrappers := make([]*model.FilterWrapper, len(filterConfigs))
+		for i, name := range c.FilterNames {
+			fc := filterConfigs[name]
+			factory := fc.Factory
+			config := fc.ParsedConfig
+			f := factory(config, m.callbacks)
+			filterWrappers[i] = model.NewFilterWrapper(name, f)
+		}
+
+		c.CanSkipMethodsOnce.Do(func() {
+			canSkipMethods := api.NewAllMethodsMap()
+			canSyncRunMethods := api.NewAllMethodsMap()
+			for _, fw := range filterWrappers {
+				f := fw.Filter
+				fc := c.FilterConfigs[fw.Name]
+				for meth := range canSkipMethods {
+					overridden, err := reflectx.IsMethodOverridden(f, meth)
+					if err != nil {
+						api.LogErrorf("failed to check method %s in filter: %v", meth, err)
+						// canSkipMethods[meth] will be false
+					}
+					canSkipMethods[meth] = canSkipMethods[meth] && !overridden
+
+					if overridden {
+						canSyncRunMethods[meth] = canSyncRunMethods[meth] && fc.SyncRunPhases.Contains(api.MethodToPhase(meth))
+					}
+				}
+			}
+			c.CanSkipMethods = canSkipMethods
+			c.CanSyncRunMethod = canSyncRunMethods
+		})
+
+		if needLogExecution() {
+			for _, fw := range filterWrappers {
+				f := fw.Filter
+				fw.Filter = NewLogExecutionFilter(fw.Name, f, m.callbacks)
+			}
+		}
+
+		if m.DebugModeEnabled() {
+			for _, fw := range filterWrappers {
+				f := fw.Filter
+				fw.Filter = NewDebugFilter(fw.Name, f, m.callbacks)
+			}
+		}
+
+		canSkipMethods := c.CanSkipMethods
+		m.canSkipDecodeData = m.canSkipDecodeData && canSkipMethods["DecodeData"] && canSkipMethods["DecodeRequest"]
+		m.canSkipDecodeTrailers = m.canSkipDecodeTrailers && canSkipMethods["DecodeTrailers"] && canSkipMethods["DecodeRequest"]
+		m.canSkipEncodeHeaders = m.canSkipEncodeData && canSkipMethods["EncodeHeaders"]
+		m.canSkipEncodeData = m.canSkipEncodeData && canSkipMethods["EncodeData"] && canSkipMethods["EncodeResponse"]
+		m.canSkipEncodeTrailers = m.canSkipEncodeTrailers && canSkipMethods["EncodeTrailers"] && canSkipMethods["EncodeResponse"]
+		m.canSkipOnLog = m.canSkipOnLog && canSkipMethods["OnLog"]
+
+		canSyncRunMethods := c.CanSyncRunMethod
+		m.canSyncRunDecodeHeaders = m.canSyncRunDecodeHeaders && canSyncRunMethods["DecodeHeaders"] && canSyncRunMethods["DecodeRequest"]
+		m.canSyncRunDecodeData = m.canSyncRunDecodeData && canSyncRunMethods["DecodeData"]
+		m.canSyncRunDecodeTrailers = m.canSyncRunDecodeTrailers && canSyncRunMethods["DecodeTrailers"]
+		m.canSyncRunEncodeHeaders = m.canSyncRunEncodeHeaders && canSyncRunMethods["EncodeHeaders"] && canSyncRunMethods["EncodeResponse"]
+		m.canSyncRunEncodeData = m.canSyncRunEncodeData && canSyncRunMethods["EncodeData"]
+		m.canSyncRunEncodeTrailers = m.canSyncRunEncodeTrailers && canSyncRunMethods["EncodeTrailers"]
+
+		// TODO: add field to control if merging is allowed
+		i := 0
+		for _, f := range m.filters {
+			if c.FilterConfigs[f.Name] == nil {
+				m.filters[i] = f
+				i++
+			}
+		}
+		m.filters = append(m.filters[:i], filterWrappers...)
+		sort.Slice(m.filters, func(i, j int) bool {
+			return pkgPlugins.ComparePluginOrder(m.filters[i].Name, m.filters[j].Name)
+		})
+
+		if api.GetLogLevel() <= api.LogLevelDebug {
+			for _, f := range m.filters {
+				fc := filterConfigs[f.Name]
+				if fc == nil {
+					// the plugin is not from consumer
+					for _, cfg := range m.config.parsed {
+						if cfg.Name == f.Name {
+							fc = cfg
+							break
+						}
+					}
+				}
+				api.LogDebugf("after merged consumer, plugin: %s, config: %+v", f.Name, fc.ParsedConfig)
+			}
+		}
+	}
+}
+
+// runComposedAuthnFilters runs the filters before consumerFiltersEndAt according to the
+// authn composition mode. Only the final authn outcome produces the local reply, so a route
+// can accept any of (or require all of) the configured Authn plugins.
+func (m *filterManager) runComposedAuthnFilters(endStream bool) (needReturn bool) {
+	anyOf := m.config.authnCompositionMode == pkgPlugins.AuthnCompositionModeAnyOf
+	var (
+		authenticated api.Consumer
+		rejection     *api.LocalResponse
+		rejectedBy    string
+		failedBy      string
+		succeeded     bool
+	)
+
+	for i := 0; i < m.config.consumerFiltersEndAt; i++ {
+		f := m.filters[i]
+		if _, ok := pkgPlugins.LoadPlugin(f.Name).(pkgPlugins.ConsumerPlugin); !ok {
+			res := m.decodeComposedAuthnHeaders(f, endStream)
+			if m.handleAction(res, api.PhaseDecodeHeaders, f) {
+				return true
+			}
+			continue
+		}
+
+		if anyOf && succeeded {
+			continue
+		}
+
+		// each Authn plugin authenticates the request independently
+		m.callbacks.consumer = nil
+		res := m.decodeComposedAuthnHeaders(f, endStream)
+		if lr, ok := res.(*api.LocalResponse); ok {
+			api.LogDebugf("authn plugin %s rejects the request, code: %d", f.Name, lr.Code)
+			if rejection == nil {
+				rejection = lr
+				rejectedBy = f.Name
+			}
+			if failedBy == "" {
+				failedBy = f.Name
+			}
+			continue
+		}
+		if m.handleAction(res, api.PhaseDecodeHeaders, f) {
+			return true
+		}
+
+		c := m.callbacks.consumer
+		if c == nil {
+			// the plugin doesn't authenticate the request
+			if failedBy == "" {
+				failedBy = f.Name
+			}
+			continue
+		}
+		if authenticated != nil && authenticated.Name() != c.Name() {
+			api.LogInfof("authn plugin %s authenticates consumer %s, which is different from %s",
+				f.Name, c.Name(), authenticated.Name())
+			if failedBy == "" {
+				failedBy = f.Name
+			}
+			continue
+		}
+		authenticated = c
+		succeeded = true
+	}
+
+	m.callbacks.consumer = authenticated
+	if anyOf {
+		if succeeded || failedBy == "" {
+			return false
+		}
+	} else if failedBy == "" {
+		return false
+	}
+
+	// A missing consumer is rejected too, so the request can't pass without any credential.
+	// When all the Authn plugins are required, a mismatched consumer is also rejected.
+	m.callbacks.consumer = nil
+	if rejection == nil {
+		rejection = &api.LocalResponse{Code: 401}
+		rejectedBy = failedBy
+	}
+	m.recordLocalReplyPluginName(rejectedBy, rejection.Code)
+	m.localReply(rejection, true)
+	return true
+}
+
+// decodeComposedAuthnHeaders runs DecodeHeaders of the filter before consumerFiltersEndAt in the
+// authn composition mode. As the authn outcome is decided before the body arrives, DecodeRequest is
+// only run when the request has no body. Otherwise, the request is rejected. Such configuration is
+// rejected during validation, but it can still be produced by merging multiple policies.
+func (m *filterManager) decodeComposedAuthnHeaders(f *model.FilterWrapper, endStream bool) api.ResultAction {
+	res := f.DecodeHeaders(m.reqHdr, endStream)
+	if res != api.WaitAllData {
+		return res
+	}
+
+	if !endStream {
+		api.LogErrorf("plugin %s needs the whole request which is not supported in the authn composition mode", f.Name)
+		return &api.LocalResponse{Code: 500}
+	}
+	return f.DecodeRequest(m.reqHdr, nil, nil)
+}
+
 func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.BufferInstance, trailers capi.RequestTrailerMap) bool {
 	// for readable
 	endStreamInBody := trailers == nil
@@ -585,9 +713,16 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 	}
 
 	n := len(m.filters)
+	// the filters from consumer are merged once the authn filters are run
+	needMerge := m.decodeIdx < m.config.consumerFiltersEndAt
 	i := m.decodeIdx + 1
-	for i < n {
-		for ; i < n; i++ {
+	start := i
+	for i < n || needMerge {
+		end := n
+		if needMerge {
+			end = m.config.consumerFiltersEndAt
+		}
+		for ; i < end; i++ {
 			f := m.filters[i]
 			// The endStream in DecodeHeaders indicates whether there is a body.
 			// The body always exists when we hit this path.
@@ -604,7 +739,7 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 		// When there are multiple filters want to decode the whole req,
 		// run part of the DecodeData which is before them
 		if hasBody {
-			for j := m.decodeIdx + 1; j < i; j++ {
+			for j := start; j < i; j++ {
 				f := m.filters[j]
 				res = f.DecodeData(buf, endStreamInBody)
 				if m.handleAction(res, api.PhaseDecodeData, f) {
@@ -614,7 +749,7 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 		}
 
 		if hasTrailers {
-			for j := m.decodeIdx + 1; j < i; j++ {
+			for j := start; j < i; j++ {
 				f := m.filters[j]
 				res = f.DecodeTrailers(trailers)
 				if m.handleAction(res, api.PhaseDecodeTrailers, f) {
@@ -632,7 +767,12 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 				return false
 			}
 			i++
+		} else if needMerge {
+			needMerge = false
+			m.mergeConsumerFilters()
+			n = len(m.filters)
 		}
+		start = i
 	}
 
 	return true
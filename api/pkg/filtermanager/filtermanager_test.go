@@ -29,6 +29,7 @@ import (
 	internalConsumer "mosn.io/htnn/api/internal/consumer"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/filtermanager/model"
+	pkgPlugins "mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 )
 
@@ -833,3 +834,386 @@ func TestSyncRunWhenProcessingBufferedDataWithFiltersFromConsumer(t *testing.T)
 	assert.Equal(t, capi.Running, res)
 	cb.WaitContinued()
 }
+
+type setConsumerInBodyFilter struct {
+	setConsumerFilter
+}
+
+func setConsumerInBodyFactory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &setConsumerInBodyFilter{
+		setConsumerFilter: setConsumerFilter{
+			callbacks: callbacks,
+			conf:      c.(setConsumerConf),
+		},
+	}
+}
+
+func (f *setConsumerInBodyFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	return api.WaitAllData
+}
+
+func (f *setConsumerInBodyFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	return f.setConsumerFilter.DecodeHeaders(headers, true)
+}
+
+func TestFiltersFromConsumerSetInDecodeRequest(t *testing.T) {
+	config := initFilterManagerConfig("ns")
+	config.consumerFiltersEndAt = 2
+
+	consumers := map[string]*internalConsumer.Consumer{}
+	c := internalConsumer.Consumer{
+		FilterConfigs: map[string]*model.ParsedFilterConfig{
+			"3_add_req": {
+				Name:    "3_add_req",
+				Factory: addReqFactory,
+				ParsedConfig: addReqConf{
+					hdrName: "x-htnn-consumer",
+				},
+			},
+		},
+	}
+	consumers["0"] = &c
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name:    "1_set_consumer",
+			Factory: setConsumerInBodyFactory,
+			ParsedConfig: setConsumerConf{
+				Consumers: consumers,
+			},
+		},
+		{
+			Name:    "2_add_req",
+			Factory: addReqFactory,
+			ParsedConfig: addReqConf{
+				hdrName: "x-htnn-authn",
+			},
+		},
+		{
+			Name:    "4_add_req",
+			Factory: addReqFactory,
+			ParsedConfig: addReqConf{
+				hdrName: "x-htnn-route",
+			},
+		},
+	}
+
+	for _, withBody := range []bool{true, false} {
+		cb := envoy.NewCAPIFilterCallbackHandler()
+		m := unwrapFilterManager(FilterManagerFactory(config, cb))
+		h := http.Header{}
+		h.Add("consumer", "0")
+		hdr := envoy.NewRequestHeaderMap(h)
+		res := m.DecodeHeaders(hdr, !withBody)
+		assert.Equal(t, capi.Running, res)
+		cb.WaitContinued()
+		if withBody {
+			_, ok := hdr.Get("x-htnn-authn")
+			assert.False(t, ok)
+
+			buf := envoy.NewBufferInstance([]byte("body"))
+			res = m.DecodeData(buf, true)
+			assert.Equal(t, capi.Running, res)
+			cb.WaitContinued()
+		}
+
+		assert.Equal(t, 4, len(m.filters))
+		for _, name := range []string{"x-htnn-authn", "x-htnn-consumer", "x-htnn-route"} {
+			_, ok := hdr.Get(name)
+			assert.True(t, ok, name)
+		}
+	}
+}
+
+type namedConsumer struct {
+	name string
+}
+
+func (c *namedConsumer) Name() string {
+	return c.name
+}
+
+func (c *namedConsumer) PluginConfig(name string) api.PluginConsumerConfig {
+	return nil
+}
+
+func (c *namedConsumer) Metadata() map[string]string {
+	return nil
+}
+
+type authnConf struct {
+	hdrName string
+}
+
+func authnFactory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &authnFilter{
+		callbacks: callbacks,
+		conf:      c.(authnConf),
+	}
+}
+
+type authnFilter struct {
+	api.PassThroughFilter
+	conf      authnConf
+	callbacks api.FilterCallbackHandler
+}
+
+func (f *authnFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	name, ok := headers.Get(f.conf.hdrName)
+	if !ok {
+		return &api.LocalResponse{Code: 401, Msg: f.conf.hdrName}
+	}
+	if name == "-" {
+		// pass the request without authenticating it
+		return api.Continue
+	}
+	f.callbacks.SetConsumer(&namedConsumer{name: name})
+	return api.Continue
+}
+
+func TestComposedAuthnFilters(t *testing.T) {
+	pkgPlugins.RegisterPlugin("authn_key", &pkgPlugins.MockConsumerPlugin{})
+	pkgPlugins.RegisterPlugin("authn_sign", &pkgPlugins.MockConsumerPlugin{})
+
+	tests := []struct {
+		name     string
+		mode     pkgPlugins.AuthnCompositionMode
+		header   http.Header
+		consumer string
+		code     int
+		msg      string
+	}{
+		{
+			name:   "none",
+			mode:   pkgPlugins.AuthnCompositionModeNone,
+			header: http.Header{"X-Sign": []string{"alice"}},
+			code:   401,
+			msg:    "x-key",
+		},
+		{
+			name:     "any of, the first one passes",
+			mode:     pkgPlugins.AuthnCompositionModeAnyOf,
+			header:   http.Header{"X-Key": []string{"alice"}},
+			consumer: "alice",
+		},
+		{
+			name:     "any of, the last one passes",
+			mode:     pkgPlugins.AuthnCompositionModeAnyOf,
+			header:   http.Header{"X-Sign": []string{"bob"}},
+			consumer: "bob",
+		},
+		{
+			name:   "any of, all fail",
+			mode:   pkgPlugins.AuthnCompositionModeAnyOf,
+			header: http.Header{},
+			code:   401,
+			msg:    "x-key",
+		},
+		{
+			name:   "any of, anonymous",
+			mode:   pkgPlugins.AuthnCompositionModeAnyOf,
+			header: http.Header{"X-Key": []string{"-"}, "X-Sign": []string{"-"}},
+			code:   401,
+		},
+		{
+			name:     "all of",
+			mode:     pkgPlugins.AuthnCompositionModeAllOf,
+			header:   http.Header{"X-Key": []string{"alice"}, "X-Sign": []string{"alice"}},
+			consumer: "alice",
+		},
+		{
+			name:   "all of, one fails",
+			mode:   pkgPlugins.AuthnCompositionModeAllOf,
+			header: http.Header{"X-Key": []string{"alice"}},
+			code:   401,
+			msg:    "x-sign",
+		},
+		{
+			name:   "all of, consumer mismatched",
+			mode:   pkgPlugins.AuthnCompositionModeAllOf,
+			header: http.Header{"X-Key": []string{"alice"}, "X-Sign": []string{"bob"}},
+			code:   401,
+		},
+		{
+			name:   "all of, not authenticated",
+			mode:   pkgPlugins.AuthnCompositionModeAllOf,
+			header: http.Header{"X-Key": []string{"alice"}, "X-Sign": []string{"-"}},
+			code:   401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := initFilterManagerConfig("ns")
+			config.authnCompositionMode = tt.mode
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name:         "authn_key",
+					Factory:      authnFactory,
+					ParsedConfig: authnConf{hdrName: "x-key"},
+				},
+				{
+					Name:         "authn_sign",
+					Factory:      authnFactory,
+					ParsedConfig: authnConf{hdrName: "x-sign"},
+				},
+			}
+			config.consumerFiltersEndAt = 2
+
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			hdr := envoy.NewRequestHeaderMap(tt.header)
+			m.DecodeHeaders(hdr, true)
+			cb.WaitContinued()
+
+			lr := cb.LocalResponse()
+			assert.Equal(t, tt.code, lr.Code)
+			if tt.msg != "" {
+				assert.Contains(t, lr.Body, tt.msg)
+			} else {
+				assert.Equal(t, "", lr.Body)
+			}
+			if tt.consumer == "" {
+				assert.Nil(t, m.callbacks.consumer)
+			} else {
+				assert.Equal(t, tt.consumer, m.callbacks.consumer.Name())
+			}
+		})
+	}
+}
+
+func TestComposedAuthnFiltersWithDecodeRequest(t *testing.T) {
+	pkgPlugins.RegisterPlugin("authn_body", &pkgPlugins.MockConsumerPlugin{})
+
+	consumers := map[string]*internalConsumer.Consumer{
+		"0": {},
+	}
+	config := initFilterManagerConfig("ns")
+	config.authnCompositionMode = pkgPlugins.AuthnCompositionModeAllOf
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name:    "authn_body",
+			Factory: setConsumerInBodyFactory,
+			ParsedConfig: setConsumerConf{
+				Consumers: consumers,
+			},
+		},
+	}
+	config.consumerFiltersEndAt = 1
+
+	for _, withBody := range []bool{true, false} {
+		cb := envoy.NewCAPIFilterCallbackHandler()
+		m := unwrapFilterManager(FilterManagerFactory(config, cb))
+		hdr := envoy.NewRequestHeaderMap(http.Header{"Consumer": []string{"0"}})
+		m.DecodeHeaders(hdr, !withBody)
+		cb.WaitContinued()
+
+		lr := cb.LocalResponse()
+		if withBody {
+			// DecodeRequest isn't supported when the body exists
+			assert.Equal(t, 500, lr.Code)
+			assert.Nil(t, m.callbacks.consumer)
+		} else {
+			assert.Equal(t, 0, lr.Code)
+			assert.Equal(t, consumers["0"], m.callbacks.consumer)
+		}
+	}
+}
+
+type addReqMergeParser struct {
+}
+
+func (p *addReqMergeParser) Parse(input interface{}) (interface{}, error) {
+	return input, nil
+}
+
+func (p *addReqMergeParser) Merge(parent interface{}, child interface{}) interface{} {
+	return addReqConf{
+		hdrName: parent.(addReqConf).hdrName + "-" + child.(addReqConf).hdrName,
+	}
+}
+
+func (p *addReqMergeParser) NonBlockingPhases() api.Phase {
+	return 0
+}
+
+func TestMergeFiltersFromConsumer(t *testing.T) {
+	pkgPlugins.RegisterHTTPFilterFactoryAndParser("2_add_req_merged", addReqFactory, &addReqMergeParser{})
+
+	config := initFilterManagerConfig("ns")
+	config.consumerFiltersEndAt = 1
+
+	c := &internalConsumer.Consumer{
+		FilterConfigs: map[string]*model.ParsedFilterConfig{
+			"2_add_req_merged": {
+				Name:         "2_add_req_merged",
+				Factory:      addReqFactory,
+				ParsedConfig: addReqConf{hdrName: "x-consumer"},
+			},
+		},
+	}
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name:    "1_set_consumer",
+			Factory: setConsumerFactory,
+			ParsedConfig: setConsumerConf{
+				Consumers: map[string]*internalConsumer.Consumer{
+					"0": c,
+				},
+			},
+		},
+		{
+			Name:         "2_add_req_merged",
+			Factory:      addReqFactory,
+			ParsedConfig: addReqConf{hdrName: "x-route"},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		cb := envoy.NewCAPIFilterCallbackHandler()
+		m := unwrapFilterManager(FilterManagerFactory(config, cb))
+		h := http.Header{}
+		h.Add("consumer", "0")
+		hdr := envoy.NewRequestHeaderMap(h)
+		m.DecodeHeaders(hdr, true)
+		cb.WaitContinued()
+
+		_, ok := hdr.Get("x-route")
+		assert.False(t, ok)
+		_, ok = hdr.Get("x-consumer")
+		assert.False(t, ok)
+		_, ok = hdr.Get("x-route-x-consumer")
+		assert.True(t, ok)
+	}
+
+	// the consumer's own config is not changed
+	assert.Equal(t, addReqConf{hdrName: "x-consumer"}, c.FilterConfigs["2_add_req_merged"].ParsedConfig)
+
+	// the merged configs of the replaced consumer are released
+	newC := &internalConsumer.Consumer{
+		FilterConfigs: map[string]*model.ParsedFilterConfig{
+			"2_add_req_merged": {
+				Name:         "2_add_req_merged",
+				Factory:      addReqFactory,
+				ParsedConfig: addReqConf{hdrName: "x-new-consumer"},
+			},
+		},
+	}
+	config.parsed[0].ParsedConfig.(setConsumerConf).Consumers["0"] = newC
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	h := http.Header{}
+	h.Add("consumer", "0")
+	hdr := envoy.NewRequestHeaderMap(h)
+	m.DecodeHeaders(hdr, true)
+	cb.WaitContinued()
+	_, ok := hdr.Get("x-route-x-new-consumer")
+	assert.True(t, ok)
+
+	n := 0
+	config.consumerFilterConfigs.Range(func(_, v any) bool {
+		n++
+		assert.Same(t, newC, v.(*mergedConsumerFilterConfigs).consumer)
+		return true
+	})
+	assert.Equal(t, 1, n)
+}
@@ -149,6 +149,37 @@ type Initer interface {
 	Init(cb api.ConfigCallbackHandler) error
 }
 
+// AuthnCompositionMode controls how the Authn plugins configured in the same route are composed.
+type AuthnCompositionMode int
+
+const (
+	// Each Authn plugin rejects the request which it can't authenticate independently. This is the default mode.
+	AuthnCompositionModeNone AuthnCompositionMode = iota
+	// The request is authenticated once any of the Authn plugins succeeds.
+	AuthnCompositionModeAnyOf
+	// The request is authenticated only when all the Authn plugins succeed with the same consumer.
+	AuthnCompositionModeAllOf
+)
+
+// AuthnComposer is implemented by the plugin configuration which changes the AuthnCompositionMode of the route.
+type AuthnComposer interface {
+	AuthnCompositionMode() AuthnCompositionMode
+}
+
+// RequestBodyAuthenticator is implemented by the Authn plugin configuration which may need the whole
+// request body to authenticate the request. Such plugin can't be composed by the AuthnComposer.
+type RequestBodyAuthenticator interface {
+	AuthenticateRequestBody() bool
+}
+
+// ConsumerImporter is implemented by the plugin configuration which accepts the consumers exported from
+// other namespaces in the route.
+type ConsumerImporter interface {
+	// ImportConsumersFrom returns the namespaces whose exported consumers are accepted. "*" means
+	// all namespaces.
+	ImportConsumersFrom() []string
+}
+
 type NativePlugin interface {
 	Plugin
 
@@ -16,6 +16,7 @@ package consumer
 
 import (
 	"mosn.io/htnn/api/internal/consumer"
+	csModel "mosn.io/htnn/api/pkg/consumer/model"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 )
 
@@ -25,3 +26,14 @@ func NewConsumer(pluginConsumerConfig map[string]api.PluginConsumerConfig) api.C
 		ConsumerConfigs: pluginConsumerConfig,
 	}
 }
+
+// NewConsumerWithMetadata creates an api.Consumer with the given metadata, which can be used to test
+// plugin which reads the consumer's metadata
+func NewConsumerWithMetadata(pluginConsumerConfig map[string]api.PluginConsumerConfig, metadata map[string]string) api.Consumer {
+	return &consumer.Consumer{
+		Consumer: csModel.Consumer{
+			Metadata: metadata,
+		},
+		ConsumerConfigs: pluginConsumerConfig,
+	}
+}
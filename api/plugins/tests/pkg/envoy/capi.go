@@ -493,6 +493,7 @@ type filterCallbackHandler struct {
 	resp        LocalResponse
 	consumer    api.Consumer
 	pluginState api.PluginState
+	namespace   string
 	ch          chan struct{}
 }
 
@@ -576,6 +577,15 @@ func (i *filterCallbackHandler) SetConsumer(c api.Consumer) {
 	i.consumer = c
 }
 
+func (i *filterCallbackHandler) Namespace() string {
+	return i.namespace
+}
+
+// SetNamespace sets the namespace returned by Namespace. It's only used in the test.
+func (i *filterCallbackHandler) SetNamespace(namespace string) {
+	i.namespace = namespace
+}
+
 func (i *filterCallbackHandler) PluginState() api.PluginState {
 	if i.pluginState == nil {
 		i.pluginState = pluginstate.NewPluginState()
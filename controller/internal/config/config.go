@@ -123,6 +123,18 @@ func UseWildcardIPv6InLDSName() bool {
 	return useWildcardIPv6InLDSName
 }
 
+var dataPlaneStatusReportURL = ""
+
+// The URL which the data plane reports the status of the applied configuration to, for example,
+// the error returned from the DynamicConfigHandler. The reported status is shown in the `Programmed`
+// condition of the resource. Status reporting is disabled when the URL is empty.
+// The data plane authenticates itself with its istio token, so the URL should be served over HTTPS.
+func DataPlaneStatusReportURL() string {
+	configLock.RLock()
+	defer configLock.RUnlock()
+	return dataPlaneStatusReportURL
+}
+
 type envStringReplacer struct {
 }
 
@@ -145,6 +157,7 @@ func Init() {
 	updateBoolIfSet(vp, "enable_native_plugin", &enableNativePlugin)
 	updateBoolIfSet(vp, "enable_lds_plugin_via_ecds", &enableLDSPluginViaECDS)
 	updateBoolIfSet(vp, "use_wildcard_ipv6_in_lds_name", &useWildcardIPv6InLDSName)
+	updateStringIfSet(vp, "data_plane_status_report_url", &dataPlaneStatusReportURL)
 
 	// The configuration below is set via the Istio directly, not via the environment variables
 	// provided when starting the Istio.
@@ -29,6 +29,7 @@ func setEnvForTest() {
 	os.Setenv("HTNN_ISTIO_ROOT_NAMESPACE", "htnn")
 	os.Setenv("HTNN_ENABLE_LDS_PLUGIN_VIA_ECDS", "true")
 	os.Setenv("HTNN_USE_WILDCARD_IPV6_IN_LDS_NAME", "true")
+	os.Setenv("HTNN_DATA_PLANE_STATUS_REPORT_URL", "https://istiod.istio-system:443/htnn/status")
 }
 
 func TestInit(t *testing.T) {
@@ -42,6 +43,7 @@ func TestInit(t *testing.T) {
 	assert.Equal(t, "istio-system", RootNamespace())
 	assert.Equal(t, false, EnableLDSPluginViaECDS())
 	assert.Equal(t, false, UseWildcardIPv6InLDSName())
+	assert.Equal(t, "", DataPlaneStatusReportURL())
 
 	setEnvForTest()
 	Init()
@@ -53,4 +55,5 @@ func TestInit(t *testing.T) {
 	assert.Equal(t, "htnn", RootNamespace())
 	assert.Equal(t, true, EnableLDSPluginViaECDS())
 	assert.Equal(t, true, UseWildcardIPv6InLDSName())
+	assert.Equal(t, "https://istiod.istio-system:443/htnn/status", DataPlaneStatusReportURL())
 }
@@ -72,6 +72,8 @@ func (r *DynamicConfigReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 type dynamicConfigReconcileState struct {
 	namespaceToDynamicConfigs map[string]map[string]*mosniov1.DynamicConfig
+	// namespace => the types of all the existing DynamicConfigs, including the invalid ones
+	namespaceToTypes map[string][]string
 }
 
 func (r *DynamicConfigReconciler) dynamicconfigsToState(ctx context.Context,
@@ -112,17 +114,28 @@ func (r *DynamicConfigReconciler) dynamicconfigsToState(ctx context.Context,
 		} else {
 			namespaceToDynamicConfigs[namespace][name] = dynamicConfig
 			dynamicConfig.SetAccepted(mosniov1.ReasonAccepted)
+			dynamicConfig.RecordRevision()
 		}
 	}
 
+	// An invalid DynamicConfig is not considered as deleted, so the data plane keeps the last known
+	// good configuration
+	namespaceToTypes := make(map[string][]string)
+	for i := range dynamicConfigs.Items {
+		dynamicConfig := &dynamicConfigs.Items[i]
+		namespace := dynamicConfig.Namespace
+		namespaceToTypes[namespace] = append(namespaceToTypes[namespace], dynamicConfig.Spec.Type)
+	}
+
 	state := &dynamicConfigReconcileState{
 		namespaceToDynamicConfigs: namespaceToDynamicConfigs,
+		namespaceToTypes:          namespaceToTypes,
 	}
 	return state, nil
 }
 
 func (r *DynamicConfigReconciler) generateCustomResource(ctx context.Context, state *dynamicConfigReconcileState) error {
-	efs := istio.GenerateDynamicConfigs(state.namespaceToDynamicConfigs)
+	efs := istio.GenerateDynamicConfigs(state.namespaceToDynamicConfigs, state.namespaceToTypes)
 	return r.Output.FromDynamicConfig(ctx, efs)
 }
 
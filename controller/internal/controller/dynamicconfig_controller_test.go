@@ -0,0 +1,230 @@
+/*
+Copyright The HTNN Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	xds "github.com/cncf/xds/go/xds/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"mosn.io/htnn/api/pkg/dynamicconfig"
+	_ "mosn.io/htnn/api/plugins/tests/pkg/envoy" // mock the envoy API used by the data plane
+	"mosn.io/htnn/controller/internal/config"
+	"mosn.io/htnn/controller/internal/dataplane"
+	"mosn.io/htnn/controller/internal/istio"
+	"mosn.io/htnn/controller/pkg/component"
+	mosniov1 "mosn.io/htnn/types/apis/v1"
+)
+
+type fakeDynamicConfigResourceManager struct {
+	component.ResourceManager
+
+	dynamicConfigs []mosniov1.DynamicConfig
+}
+
+func (m *fakeDynamicConfigResourceManager) List(_ context.Context, list client.ObjectList) error {
+	if l, ok := list.(*mosniov1.DynamicConfigList); ok {
+		l.Items = m.dynamicConfigs
+	}
+	return nil
+}
+
+type manifestTestHandler struct {
+	e2eReportHandler
+
+	deleted []string
+}
+
+func (h *manifestTestHandler) OnDelete(namespace string) error {
+	h.deleted = append(h.deleted, namespace)
+	return nil
+}
+
+func TestDeleteDynamicConfigAfterRestart(t *testing.T) {
+	handlers := map[string]*manifestTestHandler{}
+	for _, tp := range []string{"manifest_a", "manifest_b", "manifest_c"} {
+		handlers[tp] = &manifestTestHandler{}
+		dynamicconfig.RegisterDynamicConfigHandler(tp, handlers[tp])
+	}
+	newDynamicConfig := func(ns, tp string) mosniov1.DynamicConfig {
+		return mosniov1.DynamicConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      tp,
+			},
+			Spec: mosniov1.DynamicConfigSpec{
+				Type:   tp,
+				Config: runtime.RawExtension{Raw: []byte(`{}`)},
+			},
+		}
+	}
+	dispatch := func(dynamicConfigs ...mosniov1.DynamicConfig) {
+		r := &DynamicConfigReconciler{ResourceManager: &fakeDynamicConfigResourceManager{dynamicConfigs: dynamicConfigs}}
+		state, err := r.dynamicconfigsToState(context.Background(), &mosniov1.DynamicConfigList{})
+		require.NoError(t, err)
+
+		parser := &dynamicconfig.DynamicConfigParser{}
+		efs := istio.GenerateDynamicConfigs(state.namespaceToDynamicConfigs, state.namespaceToTypes)
+		for _, ef := range efs {
+			for _, cp := range ef.Spec.ConfigPatches {
+				value := cp.Patch.Value.AsMap()
+				typedConfig, ok := value["typed_config"].(map[string]interface{})
+				if !ok {
+					// the listener
+					continue
+				}
+				pluginConfig := typedConfig["plugin_config"].(map[string]interface{})
+				ts := &xds.TypedStruct{}
+				ts.Value, _ = structpb.NewStruct(pluginConfig["value"].(map[string]interface{}))
+				input, err := anypb.New(ts)
+				require.NoError(t, err)
+				_, err = parser.Parse(input, nil)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	dispatch(newDynamicConfig("ns", "manifest_a"), newDynamicConfig("ns", "manifest_b"),
+		newDynamicConfig("istio-system", "manifest_c"))
+	assert.NotNil(t, dynamicconfig.LookupDynamicConfig("manifest_b", "ns"))
+	assert.NotNil(t, dynamicconfig.LookupDynamicConfig("manifest_c", "other"))
+
+	// "manifest_b" and "manifest_c" are deleted when the controller is down. The controller doesn't
+	// remember anything before the restart.
+	dispatch(newDynamicConfig("ns", "manifest_a"))
+	assert.NotNil(t, dynamicconfig.LookupDynamicConfig("manifest_a", "ns"))
+	assert.Nil(t, dynamicconfig.LookupDynamicConfig("manifest_b", "ns"))
+	assert.Nil(t, dynamicconfig.LookupDynamicConfig("manifest_c", "other"))
+	assert.Equal(t, 0, len(handlers["manifest_a"].deleted))
+	assert.Equal(t, []string{"ns"}, handlers["manifest_b"].deleted)
+	assert.Equal(t, []string{"istio-system"}, handlers["manifest_c"].deleted)
+
+	// the last DynamicConfig is deleted
+	dispatch()
+	assert.Nil(t, dynamicconfig.LookupDynamicConfig("manifest_a", "ns"))
+	assert.Equal(t, []string{"ns"}, handlers["manifest_a"].deleted)
+}
+
+type e2eReportConfig struct {
+	*structpb.Struct
+}
+
+func (c *e2eReportConfig) Validate() error {
+	return nil
+}
+
+type e2eReportHandler struct {
+}
+
+func (h *e2eReportHandler) Config() dynamicconfig.DynamicConfig {
+	return &e2eReportConfig{Struct: &structpb.Struct{}}
+}
+
+func (h *e2eReportHandler) OnUpdate(namespace string, c any) error {
+	if _, ok := c.(*e2eReportConfig).Fields["fail"]; ok {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+type e2eDynamicConfigStore struct {
+	dynamicConfig *mosniov1.DynamicConfig
+}
+
+func (s *e2eDynamicConfigStore) Get(_ context.Context, _, _ string) (*mosniov1.DynamicConfig, error) {
+	return s.dynamicConfig.DeepCopy(), nil
+}
+
+func (s *e2eDynamicConfigStore) UpdateStatus(_ context.Context, dc *mosniov1.DynamicConfig) error {
+	s.dynamicConfig = dc
+	return nil
+}
+
+func TestDataPlaneStatusReportEndToEnd(t *testing.T) {
+	dynamicconfig.RegisterDynamicConfigHandler("e2e_report", &e2eReportHandler{})
+
+	dc := &mosniov1.DynamicConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "e2e",
+		},
+		Spec: mosniov1.DynamicConfigSpec{
+			Type: "e2e_report",
+		},
+	}
+	store := &e2eDynamicConfigStore{}
+	reported := make(chan struct{}, 1)
+	h := dataplane.NewStatusReportHandler(store, func(_ *http.Request) (*dataplane.Proxy, error) {
+		return &dataplane.Proxy{Namespace: "ns", Name: "gw-0"}, nil
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r)
+		reported <- struct{}{}
+	}))
+	defer srv.Close()
+
+	patches := gomonkey.ApplyFuncReturn(config.DataPlaneStatusReportURL, srv.URL+"/htnn/status")
+	defer patches.Reset()
+
+	for i, cfg := range []string{`{}`, `{"fail":true}`} {
+		dc.Generation = int64(i + 1)
+		dc.Spec.Config = runtime.RawExtension{Raw: []byte(cfg)}
+		store.dynamicConfig = dc.DeepCopy()
+
+		// control plane => data plane
+		efs := istio.GenerateDynamicConfigs(map[string]map[string]*mosniov1.DynamicConfig{
+			"ns": {"e2e_report": dc},
+		}, nil)
+		ef := efs[component.EnvoyFilterKey{Namespace: "ns", Name: istio.DynamicConfigEnvoyFilterName}]
+		value := ef.Spec.ConfigPatches[0].Patch.Value.AsMap()
+		pluginConfig := value["typed_config"].(map[string]interface{})["plugin_config"].(map[string]interface{})
+		ts := &xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(pluginConfig["value"].(map[string]interface{}))
+		input, err := anypb.New(ts)
+		require.NoError(t, err)
+		parser := &dynamicconfig.DynamicConfigParser{}
+		_, _ = parser.Parse(input, nil)
+
+		// data plane => control plane
+		select {
+		case <-reported:
+		case <-time.After(3 * time.Second):
+			t.Fatal("status is not reported")
+		}
+
+		cond := store.dynamicConfig.Status.Conditions[0]
+		if i == 0 {
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		} else {
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Contains(t, cond.Message, "ns/gw-0: invalid config")
+		}
+	}
+}
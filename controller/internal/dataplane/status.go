@@ -0,0 +1,118 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataplane
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+
+	"mosn.io/htnn/api/pkg/dynamicconfig"
+	"mosn.io/htnn/controller/internal/config"
+	"mosn.io/htnn/controller/internal/log"
+	mosniov1 "mosn.io/htnn/types/apis/v1"
+)
+
+// Proxy is the identity of the data plane, like the namespace and the name of the gateway pod
+type Proxy struct {
+	Namespace string
+	Name      string
+}
+
+func (p *Proxy) String() string {
+	return p.Namespace + "/" + p.Name
+}
+
+// Authenticator verifies the credential of the data plane in the request, and returns its identity
+type Authenticator func(r *http.Request) (*Proxy, error)
+
+// DynamicConfigStore reads and writes the DynamicConfig in the API server. The report is written
+// to the API server instead of being kept in memory, so that it is not lost no matter which
+// replica of the control plane receives it.
+type DynamicConfigStore interface {
+	Get(ctx context.Context, namespace, name string) (*mosniov1.DynamicConfig, error)
+	UpdateStatus(ctx context.Context, dynamicConfig *mosniov1.DynamicConfig) error
+}
+
+const maxReportSize = 64 * 1024
+
+// The data plane can only report the DynamicConfig dispatched to it, that is, the one in its namespace
+// or in the root namespace.
+func authorized(proxy *Proxy, report *dynamicconfig.StatusReport) bool {
+	return report.Namespace == proxy.Namespace || report.Namespace == config.RootNamespace()
+}
+
+// RecordDynamicConfigStatus writes the status reported by the data plane to the DynamicConfig
+func RecordDynamicConfigStatus(ctx context.Context, store DynamicConfigStore, proxy *Proxy,
+	report *dynamicconfig.StatusReport) error {
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		dynamicConfig, err := store.Get(ctx, report.Namespace, report.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// the DynamicConfig is deleted
+				return nil
+			}
+			return err
+		}
+		if !dynamicConfig.RecordDataPlaneStatus(proxy.String(), report.Generation, report.Error) {
+			// outdated report
+			return nil
+		}
+		return store.UpdateStatus(ctx, dynamicConfig)
+	})
+}
+
+// NewStatusReportHandler returns a handler which receives the status reported by the data plane.
+// The data plane is authenticated by the given authenticator, and the status is written to the
+// DynamicConfig via the store. Recording the status doesn't change the configuration, so no
+// push is needed.
+func NewStatusReportHandler(store DynamicConfigStore, authenticate Authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		proxy, err := authenticate(r)
+		if err != nil {
+			log.Errorf("failed to authenticate data plane status report: %v", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		report := &dynamicconfig.StatusReport{}
+		err = json.NewDecoder(http.MaxBytesReader(w, r.Body, maxReportSize)).Decode(report)
+		if err != nil || report.Namespace == "" || report.Name == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !authorized(proxy, report) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		log.Infof("receive status report from data plane, node: %s, DynamicConfig: %s/%s, generation: %d, error: %s",
+			proxy, report.Namespace, report.Name, report.Generation, report.Error)
+		err = RecordDynamicConfigStatus(r.Context(), store, proxy, report)
+		if err != nil {
+			log.Errorf("failed to record status report, DynamicConfig: %s/%s, err: %v", report.Namespace, report.Name, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
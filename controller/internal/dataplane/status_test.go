@@ -0,0 +1,202 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"mosn.io/htnn/api/pkg/dynamicconfig"
+	"mosn.io/htnn/controller/internal/config"
+	mosniov1 "mosn.io/htnn/types/apis/v1"
+)
+
+type fakeDynamicConfigStore struct {
+	dynamicConfigs map[types.NamespacedName]*mosniov1.DynamicConfig
+	conflicts      int
+	updated        int
+}
+
+func (s *fakeDynamicConfigStore) Get(_ context.Context, namespace, name string) (*mosniov1.DynamicConfig, error) {
+	dc, ok := s.dynamicConfigs[types.NamespacedName{Namespace: namespace, Name: name}]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "htnn.mosn.io", Resource: "dynamicconfigs"}, name)
+	}
+	return dc.DeepCopy(), nil
+}
+
+func (s *fakeDynamicConfigStore) UpdateStatus(_ context.Context, dc *mosniov1.DynamicConfig) error {
+	if s.conflicts > 0 {
+		s.conflicts--
+		return apierrors.NewConflict(schema.GroupResource{Group: "htnn.mosn.io", Resource: "dynamicconfigs"}, dc.Name,
+			errors.New("the object has been modified"))
+	}
+	s.updated++
+	s.dynamicConfigs[types.NamespacedName{Namespace: dc.Namespace, Name: dc.Name}] = dc
+	return nil
+}
+
+func newFakeDynamicConfigStore() *fakeDynamicConfigStore {
+	return &fakeDynamicConfigStore{
+		dynamicConfigs: map[types.NamespacedName]*mosniov1.DynamicConfig{
+			{Namespace: "ns", Name: "dc"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "dc", Generation: 1},
+			},
+			{Namespace: "istio-system", Name: "global"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "global", Generation: 1},
+			},
+		},
+	}
+}
+
+func TestStatusReportHandler(t *testing.T) {
+	patches := gomonkey.ApplyFuncReturn(config.RootNamespace, "istio-system")
+	defer patches.Reset()
+
+	store := newFakeDynamicConfigStore()
+	h := NewStatusReportHandler(store, func(r *http.Request) (*Proxy, error) {
+		if r.Header.Get("Authorization") != "Bearer istio-token" {
+			return nil, errors.New("bad token")
+		}
+		return &Proxy{Namespace: "ns", Name: "gw-0"}, nil
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		body   string
+		code   int
+	}{
+		{
+			name:   "bad method",
+			method: http.MethodGet,
+			code:   http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "missing token",
+			method: http.MethodPost,
+			body:   `{"namespace":"ns","name":"dc","generation":1}`,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "bad token",
+			method: http.MethodPost,
+			token:  "Bearer secret",
+			body:   `{"namespace":"ns","name":"dc","generation":1}`,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "bad body",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{`,
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "missing name",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{"namespace":"ns","generation":1}`,
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "DynamicConfig in other namespace",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{"namespace":"other","name":"dc","generation":1}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "DynamicConfig not found",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{"namespace":"ns","name":"unknown","generation":1}`,
+			code:   http.StatusOK,
+		},
+		{
+			name:   "DynamicConfig in root namespace",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{"namespace":"istio-system","name":"global","generation":1}`,
+			code:   http.StatusOK,
+		},
+		{
+			name:   "ok",
+			method: http.MethodPost,
+			token:  "Bearer istio-token",
+			body:   `{"namespace":"ns","name":"dc","generation":1,"error":"ouch"}`,
+			code:   http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+
+	assert.Equal(t, 2, store.updated)
+	dc := store.dynamicConfigs[types.NamespacedName{Namespace: "ns", Name: "dc"}]
+	require.Equal(t, 1, len(dc.Status.DataPlanes))
+	assert.Equal(t, "ns/gw-0", dc.Status.DataPlanes[0].Node)
+	assert.Equal(t, "ouch", dc.Status.DataPlanes[0].Error)
+	assert.Equal(t, string(mosniov1.ReasonFailed), dc.Status.Conditions[0].Reason)
+	dc = store.dynamicConfigs[types.NamespacedName{Namespace: "istio-system", Name: "global"}]
+	assert.Equal(t, string(mosniov1.ReasonProgrammed), dc.Status.Conditions[0].Reason)
+}
+
+func TestRecordDynamicConfigStatus(t *testing.T) {
+	store := newFakeDynamicConfigStore()
+	store.conflicts = 2
+	ctx := context.Background()
+
+	// retry on conflict
+	err := RecordDynamicConfigStatus(ctx, store, &Proxy{Namespace: "ns", Name: "gw-0"},
+		&dynamicconfig.StatusReport{Namespace: "ns", Name: "dc", Generation: 1})
+	require.Nil(t, err)
+	assert.Equal(t, 1, store.updated)
+
+	// the report from another replica is kept
+	err = RecordDynamicConfigStatus(ctx, store, &Proxy{Namespace: "ns", Name: "gw-1"},
+		&dynamicconfig.StatusReport{Namespace: "ns", Name: "dc", Generation: 1, Error: "ouch"})
+	require.Nil(t, err)
+	dc := store.dynamicConfigs[types.NamespacedName{Namespace: "ns", Name: "dc"}]
+	assert.Equal(t, 2, len(dc.Status.DataPlanes))
+	assert.Equal(t, "failed in 1 of 2 data planes: ns/gw-1: ouch", dc.Status.Conditions[0].Message)
+
+	// outdated report is ignored
+	err = RecordDynamicConfigStatus(ctx, store, &Proxy{Namespace: "ns", Name: "gw-2"},
+		&dynamicconfig.StatusReport{Namespace: "ns", Name: "dc", Generation: 0})
+	require.Nil(t, err)
+	assert.Equal(t, 2, store.updated)
+}
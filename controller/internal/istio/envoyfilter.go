@@ -46,6 +46,9 @@ const (
 	DefaultHTTPFilter            = "htnn-http-filter"
 	ECDSConsumerName             = "htnn-consumer"
 	DynamicConfigEnvoyFilterName = "htnn-dynamic-config"
+	// DynamicConfigManifestName is the name of the ECDS which delivers the manifest of DynamicConfigs.
+	// It doesn't start with the prefix of the DynamicConfig's ECDS, so it won't conflict with them.
+	DynamicConfigManifestName = "htnn-DynamicConfigManifest"
 )
 
 type configWrapper struct {
@@ -489,9 +492,55 @@ func GenerateConsumers(consumers map[string]interface{}) *istiov1a3.EnvoyFilter
 	}
 }
 
-func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosniov1.DynamicConfig) map[component.EnvoyFilterKey]*istiov1a3.EnvoyFilter {
+// dynamicConfigManifest lists the types of the existing DynamicConfigs, the global ones and the
+// namespaced ones are listed separately
+func dynamicConfigManifest(rootNamespace string, namespacedTypes map[string][]string) map[string]interface{} {
+	sortedTypes := func(types []string) []interface{} {
+		types = append([]string(nil), types...)
+		sort.Strings(types)
+		res := make([]interface{}, 0, len(types))
+		for i, tp := range types {
+			// the duplicate DynamicConfigs have the same type
+			if i > 0 && types[i-1] == tp {
+				continue
+			}
+			res = append(res, tp)
+		}
+		return res
+	}
+
+	namespaces := map[string]interface{}{}
+	for ns, types := range namespacedTypes {
+		if ns == rootNamespace || len(types) == 0 {
+			continue
+		}
+		namespaces[ns] = sortedTypes(types)
+	}
+	return map[string]interface{}{
+		"global":     sortedTypes(namespacedTypes[rootNamespace]),
+		"namespaces": namespaces,
+	}
+}
+
+// GenerateDynamicConfigs generates the EnvoyFilters which deliver the DynamicConfigs. The types in
+// namespacedTypes are all the existing DynamicConfigs, including the invalid ones. They are delivered as
+// a manifest in the root namespace, so that the data plane can delete the configs which don't exist
+// anymore, without the control plane remembering what has been deleted.
+func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosniov1.DynamicConfig,
+	namespacedTypes map[string][]string) map[component.EnvoyFilterKey]*istiov1a3.EnvoyFilter {
+
+	rootNamespace := ctrlcfg.RootNamespace()
+	namespaces := make(map[string]struct{}, len(namespacedDynamicConfigs)+1)
+	for ns := range namespacedDynamicConfigs {
+		namespaces[ns] = struct{}{}
+	}
+	// The EnvoyFilter in the root namespace is always generated to carry the manifest, even if there
+	// is no DynamicConfig, so that the last deleted DynamicConfig can be deleted in the data plane.
+	namespaces[rootNamespace] = struct{}{}
+
 	efs := map[component.EnvoyFilterKey]*istiov1a3.EnvoyFilter{}
-	for ns, dynamicConfigs := range namespacedDynamicConfigs {
+	for ns := range namespaces {
+		dynamicConfigs := namespacedDynamicConfigs[ns]
 		ef := &istiov1a3.EnvoyFilter{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: ns,
@@ -507,25 +556,73 @@ func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosn
 		// Each DynamicConfig is smaller than 1.5MB, which is the limit applied by the k8s API server (the value may be different by configured).
 		// In prod, we generate the EnvoyFilter inside the istio, so the size of EnvoyFilter doesn't matter.
 
-		configs := make([]*mosniov1.DynamicConfig, 0, len(dynamicConfigs))
-		for _, dynamicConfig := range dynamicConfigs {
-			configs = append(configs, dynamicConfig)
+		// A gateway receives the DynamicConfigs from both the root namespace and its own namespace,
+		// so the names of the ECDS and the listener need to be unique across namespaces. The names of
+		// the global ones are kept unchanged. As namespace names contain neither `/` nor `_`, the names
+		// won't conflict with each other.
+		scope := "namespace"
+		filterNamePrefix := fmt.Sprintf("htnn-DynamicConfig-%s/", ns)
+		listenerName := "htnn_dynamic_config_" + ns
+		if ns == rootNamespace {
+			scope = "global"
+			filterNamePrefix = "htnn-DynamicConfig-"
+			listenerName = "htnn_dynamic_config"
 		}
-		sort.Slice(configs, func(i, j int) bool {
-			return configs[i].Spec.Type < configs[j].Spec.Type
-		})
 
-		httpFilters := []interface{}{}
-		for _, cfg := range configs {
+		values := make(map[string]map[string]interface{}, len(dynamicConfigs))
+		for _, cfg := range dynamicConfigs {
 			var dispatchedConfig interface{}
 			_ = json.Unmarshal(cfg.Spec.Config.Raw, &dispatchedConfig)
+			value := map[string]interface{}{
+				"name":      cfg.Spec.Type,
+				"namespace": ns,
+				"scope":     scope,
+				"config":    dispatchedConfig,
+			}
+			if cfg.UID != "" {
+				// A DynamicConfig re-created with the same configuration should be applied again
+				// after it is deleted in the data plane
+				value["uid"] = string(cfg.UID)
+			}
+			if url := ctrlcfg.DataPlaneStatusReportURL(); url != "" {
+				// The data plane will report the result of applying this configuration
+				value["status_report"] = map[string]interface{}{
+					"url":        url,
+					"namespace":  cfg.Namespace,
+					"name":       cfg.Name,
+					"generation": cfg.Generation,
+				}
+			}
+			values[cfg.Spec.Type] = value
+		}
 
+		types := make([]string, 0, len(values))
+		for tp := range values {
+			types = append(types, tp)
+		}
+		sort.Strings(types)
+
+		filterNames := make([]string, 0, len(types)+1)
+		filterValues := make([]map[string]interface{}, 0, len(types)+1)
+		for _, tp := range types {
+			filterNames = append(filterNames, filterNamePrefix+tp)
+			filterValues = append(filterValues, values[tp])
+		}
+		if ns == rootNamespace {
+			filterNames = append(filterNames, DynamicConfigManifestName)
+			filterValues = append(filterValues, map[string]interface{}{
+				"manifest": dynamicConfigManifest(rootNamespace, namespacedTypes),
+			})
+		}
+
+		httpFilters := []interface{}{}
+		for i, name := range filterNames {
 			ef.Spec.ConfigPatches = append(ef.Spec.ConfigPatches, &istioapi.EnvoyFilter_EnvoyConfigObjectPatch{
 				ApplyTo: istioapi.EnvoyFilter_EXTENSION_CONFIG,
 				Patch: &istioapi.EnvoyFilter_Patch{
 					Operation: istioapi.EnvoyFilter_Patch_ADD,
 					Value: MustNewStruct(map[string]interface{}{
-						"name": fmt.Sprintf("htnn-DynamicConfig-%s", cfg.Spec.Type),
+						"name": name,
 						"typed_config": map[string]interface{}{
 							"@type":        "type.googleapis.com/envoy.extensions.filters.http.golang.v3alpha.Config",
 							"library_id":   "dc",
@@ -533,17 +630,14 @@ func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosn
 							"plugin_name":  "dc",
 							"plugin_config": map[string]interface{}{
 								"@type": "type.googleapis.com/xds.type.v3.TypedStruct",
-								"value": map[string]interface{}{
-									"name":   cfg.Spec.Type,
-									"config": dispatchedConfig,
-								},
+								"value": filterValues[i],
 							},
 						},
 					}),
 				},
 			})
 			httpFilters = append(httpFilters, map[string]interface{}{
-				"name": fmt.Sprintf("htnn-DynamicConfig-%s", cfg.Spec.Type),
+				"name": name,
 				"config_discovery": map[string]interface{}{
 					"config_source": map[string]interface{}{
 						"ads": map[string]interface{}{},
@@ -566,7 +660,7 @@ func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosn
 			Patch: &istioapi.EnvoyFilter_Patch{
 				Operation: istioapi.EnvoyFilter_Patch_ADD,
 				Value: MustNewStruct(map[string]interface{}{
-					"name":              "htnn_dynamic_config",
+					"name":              listenerName,
 					"internal_listener": map[string]interface{}{},
 					"filter_chains": []interface{}{
 						map[string]interface{}{
@@ -575,13 +669,13 @@ func GenerateDynamicConfigs(namespacedDynamicConfigs map[string]map[string]*mosn
 									"name": "envoy.filters.network.http_connection_manager",
 									"typed_config": map[string]interface{}{
 										"@type":        "type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager",
-										"stat_prefix":  "htnn_dynamic_config",
+										"stat_prefix":  listenerName,
 										"http_filters": httpFilters,
 										"route_config": map[string]interface{}{
-											"name": "htnn_dynamic_config",
+											"name": listenerName,
 											"virtual_hosts": []interface{}{
 												map[string]interface{}{
-													"name":    "htnn_dynamic_config",
+													"name":    listenerName,
 													"domains": []interface{}{"*"},
 												},
 											},
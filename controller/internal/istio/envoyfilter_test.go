@@ -23,6 +23,7 @@ import (
 	local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	"github.com/stretchr/testify/require"
 	istiov1a3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 
@@ -188,7 +189,7 @@ func TestGenerateDynamicConfigs(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, nil)
 	d, _ := yaml.Marshal(out[component.EnvoyFilterKey{
 		Namespace: "ns",
 		Name:      DynamicConfigEnvoyFilterName,
@@ -199,3 +200,149 @@ func TestGenerateDynamicConfigs(t *testing.T) {
 	want := string(d)
 	require.Equal(t, want, actual)
 }
+
+func TestGenerateDynamicConfigsWithStatusReport(t *testing.T) {
+	patch := gomonkey.ApplyFuncReturn(ctrlcfg.DataPlaneStatusReportURL, "https://istiod:443/htnn/status")
+	defer patch.Reset()
+
+	out := GenerateDynamicConfigs(map[string]map[string]*mosniov1.DynamicConfig{
+		"ns": {
+			"cb_name": {
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "ns",
+					Name:       "dc",
+					Generation: 3,
+				},
+				Spec: mosniov1.DynamicConfigSpec{
+					Type: "cb_name",
+					Config: runtime.RawExtension{
+						Raw: []byte(`{"key": "value"}`),
+					},
+				},
+			},
+		},
+	}, nil)
+	ef := out[component.EnvoyFilterKey{
+		Namespace: "ns",
+		Name:      DynamicConfigEnvoyFilterName,
+	}]
+	value := ef.Spec.ConfigPatches[0].Patch.Value.AsMap()
+	pluginConfig := value["typed_config"].(map[string]interface{})["plugin_config"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"url":        "https://istiod:443/htnn/status",
+		"namespace":  "ns",
+		"name":       "dc",
+		"generation": float64(3),
+	}, pluginConfig["value"].(map[string]interface{})["status_report"])
+}
+
+func TestGenerateDynamicConfigsInBothScopes(t *testing.T) {
+	newConfig := func() *mosniov1.DynamicConfig {
+		return &mosniov1.DynamicConfig{
+			Spec: mosniov1.DynamicConfigSpec{
+				Type: "cb_name",
+				Config: runtime.RawExtension{
+					Raw: []byte(`{"key": "value"}`),
+				},
+			},
+		}
+	}
+	out := GenerateDynamicConfigs(map[string]map[string]*mosniov1.DynamicConfig{
+		"istio-system": {
+			"cb_name": newConfig(),
+		},
+		"ns": {
+			"cb_name": newConfig(),
+		},
+	}, nil)
+
+	// the gateway in "ns" receives both EnvoyFilters, so the names should not conflict
+	names := map[string]struct{}{}
+	for _, c := range []struct {
+		namespace    string
+		scope        string
+		filterName   string
+		listenerName string
+	}{
+		{"istio-system", "global", "htnn-DynamicConfig-cb_name", "htnn_dynamic_config"},
+		{"ns", "namespace", "htnn-DynamicConfig-ns/cb_name", "htnn_dynamic_config_ns"},
+	} {
+		ef := out[component.EnvoyFilterKey{
+			Namespace: c.namespace,
+			Name:      DynamicConfigEnvoyFilterName,
+		}]
+		require.NotNil(t, ef)
+		value := ef.Spec.ConfigPatches[0].Patch.Value.AsMap()
+		require.Equal(t, c.filterName, value["name"])
+		pluginConfig := value["typed_config"].(map[string]interface{})["plugin_config"].(map[string]interface{})
+		dispatched := pluginConfig["value"].(map[string]interface{})
+		require.Equal(t, c.namespace, dispatched["namespace"])
+		require.Equal(t, c.scope, dispatched["scope"])
+
+		listener := ef.Spec.ConfigPatches[len(ef.Spec.ConfigPatches)-1].Patch.Value.AsMap()
+		require.Equal(t, c.listenerName, listener["name"])
+		names[c.filterName] = struct{}{}
+		names[c.listenerName] = struct{}{}
+	}
+	require.Equal(t, 4, len(names))
+}
+
+func TestGenerateDynamicConfigsWithManifest(t *testing.T) {
+	out := GenerateDynamicConfigs(map[string]map[string]*mosniov1.DynamicConfig{
+		"ns": {
+			"cb_name": {
+				ObjectMeta: metav1.ObjectMeta{
+					UID: "uid",
+				},
+				Spec: mosniov1.DynamicConfigSpec{
+					Type: "cb_name",
+					Config: runtime.RawExtension{
+						Raw: []byte(`{"key": "value"}`),
+					},
+				},
+			},
+		},
+	}, map[string][]string{
+		// the invalid and the duplicate DynamicConfigs are listed too
+		"ns":           {"invalid", "cb_name", "cb_name"},
+		"istio-system": {"global"},
+	})
+	require.Equal(t, 2, len(out))
+
+	ef := out[component.EnvoyFilterKey{
+		Namespace: "ns",
+		Name:      DynamicConfigEnvoyFilterName,
+	}]
+	// one ECDS and one listener
+	require.Equal(t, 2, len(ef.Spec.ConfigPatches))
+	value := ef.Spec.ConfigPatches[0].Patch.Value.AsMap()
+	pluginConfig := value["typed_config"].(map[string]interface{})["plugin_config"].(map[string]interface{})
+	require.Equal(t, "uid", pluginConfig["value"].(map[string]interface{})["uid"])
+
+	ef = out[component.EnvoyFilterKey{
+		Namespace: "istio-system",
+		Name:      DynamicConfigEnvoyFilterName,
+	}]
+	// the manifest and the listener
+	require.Equal(t, 2, len(ef.Spec.ConfigPatches))
+	value = ef.Spec.ConfigPatches[0].Patch.Value.AsMap()
+	require.Equal(t, DynamicConfigManifestName, value["name"])
+	pluginConfig = value["typed_config"].(map[string]interface{})["plugin_config"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"manifest": map[string]interface{}{
+			"global": []interface{}{"global"},
+			"namespaces": map[string]interface{}{
+				"ns": []interface{}{"cb_name", "invalid"},
+			},
+		},
+	}, pluginConfig["value"])
+
+	// the manifest is delivered even if there is no DynamicConfig
+	out = GenerateDynamicConfigs(nil, nil)
+	require.Equal(t, 1, len(out))
+	ef = out[component.EnvoyFilterKey{
+		Namespace: "istio-system",
+		Name:      DynamicConfigEnvoyFilterName,
+	}]
+	require.Equal(t, 2, len(ef.Spec.ConfigPatches))
+}
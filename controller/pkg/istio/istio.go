@@ -18,16 +18,23 @@ package istio
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"mosn.io/htnn/controller/internal/config"
 	"mosn.io/htnn/controller/internal/controller"
+	"mosn.io/htnn/controller/internal/dataplane"
 	"mosn.io/htnn/controller/internal/log"
 	"mosn.io/htnn/controller/internal/metrics"
 	"mosn.io/htnn/controller/internal/registry"
 	"mosn.io/htnn/controller/pkg/component"
+	mosniov1 "mosn.io/htnn/types/apis/v1"
+	"mosn.io/htnn/types/pkg/client/clientset/versioned"
 )
 
 type Reconciler interface {
@@ -82,6 +89,56 @@ func NewDynamicConfigReconciler(output component.Output, manager component.Resou
 	}
 }
 
+// DataPlaneAuthenticator verifies the credential of the data plane in the request, and returns
+// the namespace and the name of the data plane. The host should use the same authentication as xDS,
+// so that each data plane can only report as itself.
+type DataPlaneAuthenticator func(r *http.Request) (namespace string, name string, err error)
+
+type dynamicConfigStore struct {
+	client versioned.Interface
+}
+
+func (s *dynamicConfigStore) Get(ctx context.Context, namespace, name string) (*mosniov1.DynamicConfig, error) {
+	return s.client.ApisV1().DynamicConfigs(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (s *dynamicConfigStore) UpdateStatus(ctx context.Context, dynamicConfig *mosniov1.DynamicConfig) error {
+	_, err := s.client.ApisV1().DynamicConfigs(dynamicConfig.Namespace).UpdateStatus(ctx, dynamicConfig, metav1.UpdateOptions{})
+	return err
+}
+
+// NewDataPlaneStatusHandler returns the handler which receives the status reported by the data plane.
+// It should be served over HTTPS at the path returned from DataPlaneStatusReportPath. The reported
+// status is written to the DynamicConfig via the API server, so every replica can serve it and
+// no push is required.
+func NewDataPlaneStatusHandler(restConfig *rest.Config, authenticate DataPlaneAuthenticator) (http.Handler, error) {
+	client, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return dataplane.NewStatusReportHandler(&dynamicConfigStore{client: client},
+		func(r *http.Request) (*dataplane.Proxy, error) {
+			namespace, name, err := authenticate(r)
+			if err != nil {
+				return nil, err
+			}
+			return &dataplane.Proxy{Namespace: namespace, Name: name}, nil
+		}), nil
+}
+
+// DataPlaneStatusReportPath returns the path of the URL configured via `HTNN_DATA_PLANE_STATUS_REPORT_URL`.
+// It returns empty string if the status reporting is disabled.
+func DataPlaneStatusReportPath() string {
+	u, err := url.Parse(config.DataPlaneStatusReportURL())
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	if u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
+
 func SetLogger(logger component.CtrlLogger) {
 	log.SetLogger(logger)
 }
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"mosn.io/htnn/controller/internal/config"
 	"mosn.io/htnn/controller/tests/integration/helper"
 	"mosn.io/htnn/controller/tests/pkg"
 	mosniov1 "mosn.io/htnn/types/apis/v1"
@@ -100,7 +101,7 @@ var _ = Describe("DynamicConfig controller", func() {
 					return false
 				}
 				for _, item := range envoyfilters.Items {
-					if item.Name == "htnn-dynamic-config" {
+					if item.Namespace == "default" && item.Name == "htnn-dynamic-config" {
 						return true
 					}
 				}
@@ -135,12 +136,14 @@ var _ = Describe("DynamicConfig controller", func() {
 					return false
 				}
 				for _, item := range envoyfilters.Items {
-					if item.Name == "htnn-dynamic-config" {
+					if item.Namespace == "default" && item.Name == "htnn-dynamic-config" {
 						return false
 					}
 				}
 				return true
 			}, timeout, interval).Should(BeTrue())
+			// the manifest in the root namespace is kept
+			Expect(envoyfilters.Items).To(ContainElement(HaveField("ObjectMeta.Namespace", config.RootNamespace())))
 
 			// back to valid
 			base = client.MergeFrom(c.DeepCopy())
@@ -169,7 +172,7 @@ var _ = Describe("DynamicConfig controller", func() {
 					return false
 				}
 				for _, item := range envoyfilters.Items {
-					if item.Name == "htnn-dynamic-config" {
+					if item.Namespace == "default" && item.Name == "htnn-dynamic-config" {
 						return true
 					}
 				}
@@ -68,6 +68,13 @@ func init() {
 			require.Equal(t, 1, len(rsp.Header["Demokey"]), rsp)
 			require.Equal(t, "value2", rsp.Header["Demokey"][0])
 
+			// the previous configuration is kept in the revision history
+			err = c.Get(ctx, nsName, &dynamicConfig)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(dynamicConfig.Status.Revisions))
+			require.Equal(t, gen, dynamicConfig.Status.Revisions[0].Generation)
+			require.JSONEq(t, `{"key":"value"}`, string(dynamicConfig.Status.Revisions[0].Config.Raw))
+
 			// test webhook
 			base = client.MergeFrom(dynamicConfig.DeepCopy())
 			dynamicConfig.Spec.Config.Raw = []byte(`{"key":""}`)
@@ -81,9 +88,8 @@ func init() {
 
 			time.Sleep(1 * time.Second)
 			rsp, _ = suite.Get("/echo", nil)
-			// delete the resource won't trigger the OnUpdate callback
-			require.Equal(t, 1, len(rsp.Header["Demokey"]), rsp)
-			require.Equal(t, "value2", rsp.Header["Demokey"][0])
+			// the deleted configuration can't be looked up anymore
+			require.Equal(t, 0, len(rsp.Header["Demokey"]), rsp)
 		},
 	})
 }
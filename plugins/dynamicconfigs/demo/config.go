@@ -20,10 +20,6 @@ import (
 	"mosn.io/htnn/types/dynamicconfigs/demo"
 )
 
-var (
-	demoKey string
-)
-
 func init() {
 	// Register the implementation of DynamicConfig demo
 	dynamicconfig.RegisterDynamicConfigHandler("demo", &handler{})
@@ -34,16 +30,14 @@ type handler struct {
 }
 
 // OnUpdate will be called when the dynamic config is updated
-func (d *handler) OnUpdate(config any) error {
+func (d *handler) OnUpdate(namespace string, config any) error {
 	c := config.(*demo.Config)
-	api.LogInfof("demo dynamic config: %v", c)
-
-	demoKey = c.Key
+	api.LogInfof("demo dynamic config: %v, namespace: %s", c, namespace)
 	return nil
 }
 
-// GetDemoKey can be used in the plugins written in Go. It is for show case, don't call it in
-// the production code.
-func GetDemoKey() string {
-	return demoKey
+// OnDelete will be called when the dynamic config is deleted
+func (d *handler) OnDelete(namespace string) error {
+	api.LogInfof("demo dynamic config is deleted, namespace: %s", namespace)
+	return nil
 }
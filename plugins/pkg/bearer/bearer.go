@@ -0,0 +1,59 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bearer contains the helpers shared by the plugins which authenticate the request
+// with the bearer token (RFC 6750).
+package bearer
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// Unauthorized rejects the request with the `WWW-Authenticate: Bearer` challenge. The errCode,
+// like `invalid_token`, is added to the challenge if it is not empty.
+func Unauthorized(msg string, errCode string) api.ResultAction {
+	challenge := "Bearer"
+	if errCode != "" {
+		challenge += ` error="` + errCode + `"`
+	}
+	hdr := http.Header{}
+	hdr.Set("WWW-Authenticate", challenge)
+	return &api.LocalResponse{
+		Code:   401,
+		Msg:    msg,
+		Header: hdr,
+	}
+}
+
+// ClaimToString returns the string claim as it is, and encodes the claim in other types in JSON.
+func ClaimToString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// SetClaimsToHeaders sends the claims to the upstream as headers. The missing claims are ignored.
+func SetClaimsToHeaders(headers api.RequestHeaderMap, claims map[string]any, chs []*v1.ClaimToHeader) {
+	for _, ch := range chs {
+		if v, ok := claims[ch.Claim]; ok {
+			headers.Set(ch.Header, ClaimToString(v))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bearer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestUnauthorized(t *testing.T) {
+	lr := Unauthorized("missing token", "").(*api.LocalResponse)
+	assert.Equal(t, 401, lr.Code)
+	assert.Equal(t, "missing token", lr.Msg)
+	assert.Equal(t, "Bearer", lr.Header.Get("WWW-Authenticate"))
+
+	lr = Unauthorized("invalid token", "invalid_token").(*api.LocalResponse)
+	assert.Equal(t, `Bearer error="invalid_token"`, lr.Header.Get("WWW-Authenticate"))
+}
+
+func TestSetClaimsToHeaders(t *testing.T) {
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	claims := map[string]any{
+		"sub":   "rick",
+		"scope": []any{"read", "write"},
+		"exp":   float64(1700000000),
+	}
+	SetClaimsToHeaders(hdr, claims, []*v1.ClaimToHeader{
+		{Claim: "sub", Header: "x-user"},
+		{Claim: "scope", Header: "x-scope"},
+		{Claim: "exp", Header: "x-exp"},
+		{Claim: "missing", Header: "x-missing"},
+	})
+
+	v, _ := hdr.Get("x-user")
+	assert.Equal(t, "rick", v)
+	v, _ = hdr.Get("x-scope")
+	assert.Equal(t, `["read","write"]`, v)
+	v, _ = hdr.Get("x-exp")
+	assert.Equal(t, "1700000000", v)
+	_, ok := hdr.Get("x-missing")
+	assert.False(t, ok)
+}
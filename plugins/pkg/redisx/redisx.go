@@ -0,0 +1,72 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisx
+
+import (
+	"crypto/tls"
+
+	"github.com/redis/go-redis/v9"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// Options are the options to connect to Redis. Either Address or ClusterAddresses should be set.
+type Options struct {
+	Address          string
+	ClusterAddresses []string
+
+	Username string
+	Password string
+
+	TLS           bool
+	TLSSkipVerify bool
+}
+
+// NewClient creates a *redis.Client if the Address is set, otherwise a *redis.ClusterClient.
+func NewClient(opts *Options) redis.UniversalClient {
+	var tlsConfig *tls.Config
+	if opts.TLS {
+		tlsConfig = &tls.Config{
+			InsecureSkipVerify: opts.TLSSkipVerify,
+		}
+	}
+
+	if opts.Address != "" {
+		return redis.NewClient(&redis.Options{
+			Addr:      opts.Address,
+			Username:  opts.Username,
+			Password:  opts.Password,
+			TLSConfig: tlsConfig,
+		})
+	}
+	return redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs:     opts.ClusterAddresses,
+		Username:  opts.Username,
+		Password:  opts.Password,
+		TLSConfig: tlsConfig,
+	})
+}
+
+// NewClientFromConfig creates the client from the shared Redis configuration of plugins.
+func NewClientFromConfig(conf *v1.Redis) redis.UniversalClient {
+	return NewClient(&Options{
+		Address:          conf.GetAddress(),
+		ClusterAddresses: conf.GetCluster().GetAddresses(),
+		Username:         conf.Username,
+		Password:         conf.Password,
+		TLS:              conf.Tls,
+		TLSSkipVerify:    conf.TlsSkipVerify,
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisx
+
+import (
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestNewClientFromConfig(t *testing.T) {
+	client := NewClientFromConfig(&v1.Redis{
+		Source:   &v1.Redis_Address{Address: "127.0.0.1:6379"},
+		Username: "user",
+		Password: "passwd",
+	})
+	defer client.Close()
+	c, ok := client.(*redis.Client)
+	if assert.True(t, ok) {
+		assert.Equal(t, "127.0.0.1:6379", c.Options().Addr)
+		assert.Equal(t, "user", c.Options().Username)
+		assert.Nil(t, c.Options().TLSConfig)
+	}
+
+	client = NewClientFromConfig(&v1.Redis{
+		Source: &v1.Redis_Cluster{Cluster: &v1.RedisCluster{
+			Addresses: []string{"127.0.0.1:6379", "127.0.0.1:6380"},
+		}},
+		Tls:           true,
+		TlsSkipVerify: true,
+	})
+	defer client.Close()
+	cc, ok := client.(*redis.ClusterClient)
+	if assert.True(t, ok) {
+		assert.Equal(t, []string{"127.0.0.1:6379", "127.0.0.1:6380"}, cc.Options().Addrs)
+		assert.True(t, cc.Options().TLSConfig.InsecureSkipVerify)
+	}
+}
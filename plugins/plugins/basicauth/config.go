@@ -0,0 +1,75 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+const (
+	verifiedPasswordTTL  = 5 * time.Minute
+	maxVerifiedPasswords = 10000
+)
+
+func init() {
+	plugins.RegisterPlugin(basicauth.Name, &plugin{})
+}
+
+type plugin struct {
+	basicauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+type config struct {
+	basicauth.Config
+
+	challenge string
+	// username & the digest of the password => the consumer config which is verified
+	verifiedPasswords *ttlcache.Cache[string, *basicauth.CustomConsumerConfig]
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	realm := conf.Realm
+	if realm == "" {
+		realm = "htnn"
+	}
+	conf.challenge = `Basic realm="` + realm + `", charset="UTF-8"`
+
+	// Verifying the password hash is expensive by design, so we cache the successful verifications
+	conf.verifiedPasswords = ttlcache.New(
+		ttlcache.WithTTL[string, *basicauth.CustomConsumerConfig](verifiedPasswordTTL),
+		ttlcache.WithCapacity[string, *basicauth.CustomConsumerConfig](maxVerifiedPasswords),
+		ttlcache.WithDisableTouchOnHit[string, *basicauth.CustomConsumerConfig](),
+	)
+	go conf.verifiedPasswords.Start()
+	runtime.SetFinalizer(conf, func(conf *config) {
+		conf.verifiedPasswords.Stop()
+	})
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		challenge string
+		err       string
+	}{
+		{
+			name:      "default realm",
+			input:     `{}`,
+			challenge: `Basic realm="htnn", charset="UTF-8"`,
+		},
+		{
+			name:      "realm",
+			input:     `{"realm":"my app"}`,
+			challenge: `Basic realm="my app", charset="UTF-8"`,
+		},
+		{
+			name:  "invalid realm",
+			input: `{"realm":"my \"app\""}`,
+			err:   "invalid Config.Realm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				require.Nil(t, err)
+				require.NoError(t, conf.Init(nil))
+				assert.Equal(t, tt.challenge, conf.challenge)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConsumerConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		index string
+		err   string
+	}{
+		{
+			name:  "bcrypt",
+			input: `{"username":"rick","password":"$2y$05$WcO4Xhy0aT2QXi1xSr5MPOHkW4Ax4wXQqPjDiE0qZLxpEAUgX3f5q"}`,
+			index: "rick",
+		},
+		{
+			name:  "argon2id",
+			input: `{"username":"rick","password":"$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$aGFzaGhhc2g"}`,
+			index: "rick",
+		},
+		{
+			name:  "argon2id without threads",
+			input: `{"username":"rick","password":"$argon2id$v=19$m=65536,t=3,p=0$c2FsdHNhbHQ$aGFzaGhhc2g"}`,
+			err:   "argon2id threads should be in [1, 16], got 0",
+		},
+		{
+			name:  "argon2id with too much memory",
+			input: `{"username":"rick","password":"$argon2id$v=19$m=4194304,t=3,p=4$c2FsdHNhbHQ$aGFzaGhhc2g"}`,
+			err:   "argon2id memory should be in [1, 262144], got 4194304",
+		},
+		{
+			name:  "argon2id without time",
+			input: `{"username":"rick","password":"$argon2id$v=19$m=65536,t=0,p=4$c2FsdHNhbHQ$aGFzaGhhc2g"}`,
+			err:   "argon2id time should be in [1, 16], got 0",
+		},
+		{
+			name:  "malformed argon2id",
+			input: `{"username":"rick","password":"$argon2id$v=19$m=65536"}`,
+			err:   "invalid argon2id hash",
+		},
+		{
+			name:  "bcrypt with too large cost",
+			input: `{"username":"rick","password":"$2y$31$WcO4Xhy0aT2QXi1xSr5MPOHkW4Ax4wXQqPjDiE0qZLxpEAUgX3f5q"}`,
+			err:   "bcrypt cost should be in [4, 14], got 31",
+		},
+		{
+			name:  "username contains colon",
+			input: `{"username":"ri:ck","password":"$2y$05$WcO4Xhy0aT2QXi1xSr5MPOHkW4Ax4wXQqPjDiE0qZLxpEAUgX3f5q"}`,
+			err:   "invalid ConsumerConfig.Username",
+		},
+		{
+			name:  "plaintext password",
+			input: `{"username":"rick","password":"secret"}`,
+			err:   "invalid ConsumerConfig.Password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &basicauth.CustomConsumerConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.index, conf.Index())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
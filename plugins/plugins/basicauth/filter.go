@@ -0,0 +1,173 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+}
+
+func (f *filter) unauthorized(msg string) api.ResultAction {
+	hdr := http.Header{}
+	hdr.Set("WWW-Authenticate", f.config.challenge)
+	return &api.LocalResponse{
+		Code:   401,
+		Msg:    msg,
+		Header: hdr,
+	}
+}
+
+// verifyArgon2id verifies the password against the hash in PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+func verifyArgon2id(hash string, password string) (bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, errors.New("invalid argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return false, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, fmt.Errorf("invalid argon2id hash: %w", err)
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(expected)))
+	return subtle.ConstantTimeCompare(actual, expected) == 1, nil
+}
+
+// dummyHash is a bcrypt hash with the default cost, which is used when the consumer is not found.
+// The time to verify it only matches the consumers whose password is hashed by bcrypt with the
+// same cost.
+const dummyHash = "$2a$10$lt8hXJyUJekQU8dYaabI8uhpyS9PY6Ui6Q2xWln1vTKXIiqQn0PvG"
+
+func verifyPassword(hash string, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		return verifyArgon2id(hash, password)
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (f *filter) verifyPasswordWithCache(conf *basicauth.CustomConsumerConfig, password string) (bool, error) {
+	// Only the digest of the password is kept in memory
+	digest := sha256.Sum256([]byte(password))
+	key := conf.Username + "." + base64.StdEncoding.EncodeToString(digest[:])
+	// The cached result is stale if the consumer is updated
+	if item := f.config.verifiedPasswords.Get(key); item != nil && item.Value() == conf {
+		return true, nil
+	}
+
+	matched, err := verifyPassword(conf.Password, password)
+	if !matched {
+		return false, err
+	}
+	f.config.verifiedPasswords.Set(key, conf, ttlcache.DefaultTTL)
+	return true, nil
+}
+
+func parseCredential(value string) (username string, password string, ok bool) {
+	const prefix = "Basic "
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[len(prefix):]))
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	vals := headers.Values("authorization")
+	if len(vals) == 0 {
+		return f.unauthorized("missing credential")
+	}
+	if len(vals) > 1 {
+		return f.unauthorized("duplicate credential found")
+	}
+
+	username, password, ok := parseCredential(vals[0])
+	if !ok {
+		return f.unauthorized("invalid credential")
+	}
+
+	c, ok := f.callbacks.LookupConsumer(basicauth.Name, username)
+	if !ok {
+		// verify against a dummy hash, so the unknown usernames can't be found by the response time
+		verifyPassword(dummyHash, password)
+		return f.unauthorized("invalid username or password")
+	}
+	conf, _ := c.PluginConfig(basicauth.Name).(*basicauth.CustomConsumerConfig)
+	matched, err := f.verifyPasswordWithCache(conf, password)
+	if err != nil {
+		api.LogErrorf("bad password hash of consumer %s: %v", c.Name(), err)
+	}
+	if !matched {
+		return f.unauthorized("invalid username or password")
+	}
+
+	if f.config.HideCredentials {
+		headers.Del("authorization")
+	}
+	f.callbacks.SetConsumer(c)
+	return api.Continue
+}
@@ -0,0 +1,212 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func argon2idHash(password string) string {
+	salt := []byte("somesalt")
+	hash := argon2.IDKey([]byte(password), salt, 1, 64, 1, 16)
+	return fmt.Sprintf("$argon2id$v=%d$m=64,t=1,p=1$%s$%s", argon2.Version,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash))
+}
+
+func basic(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func TestBasicAuth(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	consumers := map[string]*basicauth.CustomConsumerConfig{}
+	for _, c := range []*basicauth.CustomConsumerConfig{
+		{ConsumerConfig: basicauth.ConsumerConfig{Username: "bcrypt", Password: string(bcryptHash)}},
+		{ConsumerConfig: basicauth.ConsumerConfig{Username: "argon", Password: argon2idHash("secret")}},
+		{ConsumerConfig: basicauth.ConsumerConfig{Username: "broken", Password: "$argon2id$v=19$m=64"}},
+	} {
+		consumers[c.Index()] = c
+	}
+
+	tests := []struct {
+		name   string
+		conf   string
+		auth   []string
+		status int
+		hide   bool
+	}{
+		{
+			name: "bcrypt",
+			auth: []string{basic("bcrypt", "secret")},
+		},
+		{
+			name: "case insensitive scheme",
+			auth: []string{"basic " + base64.StdEncoding.EncodeToString([]byte("bcrypt:secret"))},
+		},
+		{
+			name:   "bcrypt mismatched",
+			auth:   []string{basic("bcrypt", "secrets")},
+			status: 401,
+		},
+		{
+			name: "argon2id",
+			auth: []string{basic("argon", "secret")},
+		},
+		{
+			name:   "argon2id mismatched",
+			auth:   []string{basic("argon", "")},
+			status: 401,
+		},
+		{
+			name:   "broken hash",
+			auth:   []string{basic("broken", "secret")},
+			status: 401,
+		},
+		{
+			name:   "unknown user",
+			auth:   []string{basic("unknown", "secret")},
+			status: 401,
+		},
+		{
+			name:   "missing",
+			status: 401,
+		},
+		{
+			name:   "duplicate",
+			auth:   []string{basic("bcrypt", "secret"), basic("argon", "secret")},
+			status: 401,
+		},
+		{
+			name:   "not basic",
+			auth:   []string{"Bearer xxx"},
+			status: 401,
+		},
+		{
+			name:   "bad encoding",
+			auth:   []string{"Basic !!"},
+			status: 401,
+		},
+		{
+			name:   "without colon",
+			auth:   []string{"Basic " + base64.StdEncoding.EncodeToString([]byte("bcrypt"))},
+			status: 401,
+		},
+		{
+			name: "hide credentials",
+			conf: `{"hideCredentials":true}`,
+			auth: []string{basic("bcrypt", "secret")},
+			hide: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			conf := &config{}
+			input := tt.conf
+			if input == "" {
+				input = `{"realm":"test"}`
+			}
+			require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+			require.NoError(t, conf.Init(nil))
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+				c, ok := consumers[key]
+				if !ok {
+					return nil, false
+				}
+				return consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+					basicauth.Name: c,
+				}), true
+			})
+			defer patches.Reset()
+
+			h := http.Header{}
+			for _, v := range tt.auth {
+				h.Add("Authorization", v)
+			}
+			hdr := envoy.NewRequestHeaderMap(h)
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+				assert.Equal(t, `Basic realm="test", charset="UTF-8"`, r.Header.Get("WWW-Authenticate"))
+			} else {
+				assert.Equal(t, api.Continue, res)
+				assert.NotNil(t, cb.GetConsumer())
+				_, found := hdr.Get("authorization")
+				assert.Equal(t, !tt.hide, found)
+			}
+		})
+	}
+}
+
+func TestDummyHash(t *testing.T) {
+	cost, err := bcrypt.Cost([]byte(dummyHash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.DefaultCost, cost)
+}
+
+func TestVerifiedPasswordsCache(t *testing.T) {
+	conf := &config{}
+	require.NoError(t, conf.Init(nil))
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+
+	c := &basicauth.CustomConsumerConfig{
+		ConsumerConfig: basicauth.ConsumerConfig{Username: "argon", Password: argon2idHash("secret")},
+	}
+	matched, _ := f.verifyPasswordWithCache(c, "secrets")
+	assert.False(t, matched)
+	assert.Equal(t, 0, conf.verifiedPasswords.Len())
+	matched, _ = f.verifyPasswordWithCache(c, "secret")
+	assert.True(t, matched)
+	assert.Equal(t, 1, conf.verifiedPasswords.Len())
+
+	patches := gomonkey.ApplyFunc(verifyPassword, func(_ string, _ string) (bool, error) {
+		return false, nil
+	})
+	defer patches.Reset()
+	// hit the cache
+	matched, _ = f.verifyPasswordWithCache(c, "secret")
+	assert.True(t, matched)
+	matched, _ = f.verifyPasswordWithCache(c, "secrets")
+	assert.False(t, matched)
+
+	// the consumer is updated
+	updated := &basicauth.CustomConsumerConfig{
+		ConsumerConfig: basicauth.ConsumerConfig{Username: "argon", Password: c.Password},
+	}
+	matched, _ = f.verifyPasswordWithCache(updated, "secret")
+	assert.False(t, matched)
+}
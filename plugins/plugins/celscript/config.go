@@ -15,6 +15,8 @@
 package celscript
 
 import (
+	"fmt"
+
 	"github.com/google/cel-go/cel"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
@@ -40,14 +42,17 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	celscript.CustomConfig
+	celscript.Config
 
 	allowIfScript expr.Script
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	if conf.AllowIf != "" {
-		s, _ := expr.CompileCel(conf.AllowIf, cel.BoolType)
+		s, err := expr.CompileCel(conf.AllowIf, cel.BoolType)
+		if err != nil {
+			return fmt.Errorf("invalid allow_if: %w", err)
+		}
 		conf.allowIfScript = s
 	}
 	return nil
@@ -53,6 +53,10 @@ func TestConfig(t *testing.T) {
 				err = conf.Init(nil)
 				assert.Nil(t, err)
 			} else {
+				if err == nil {
+					// the CEL expressions are compiled during initialization
+					err = conf.Init(nil)
+				}
 				assert.ErrorContains(t, err, tt.err)
 			}
 		})
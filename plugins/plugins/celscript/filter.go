@@ -19,10 +19,16 @@ import (
 )
 
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
-	return &filter{
+	f := &filter{
 		callbacks: callbacks,
 		config:    c.(*config),
 	}
+	// Defining DecodeRequest makes the request body go through the filter manager, so only
+	// use it when the script reads the body.
+	if f.config.allowIfScript != nil && f.config.allowIfScript.NeedRequestBody() {
+		return &bodyFilter{filter: f}
+	}
+	return f
 }
 
 type filter struct {
@@ -34,18 +40,36 @@ type filter struct {
 
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
 	config := f.config
-	if config.allowIfScript != nil {
-		res, err := config.allowIfScript.EvalWithRequest(f.callbacks, headers)
-		if err != nil {
-			api.LogErrorf("failed to eval script with request: %v", err)
-			return &api.LocalResponse{Code: 503}
-		}
-
-		allowed := res.(bool)
-		if !allowed {
-			api.LogInfo("celScript rejects request")
-			return &api.LocalResponse{Code: 403}
-		}
+	if config.allowIfScript == nil {
+		return api.Continue
+	}
+
+	if config.allowIfScript.NeedRequestBody() && !endStream {
+		return api.WaitAllData
+	}
+	res, err := config.allowIfScript.EvalWithRequest(f.callbacks, headers)
+	return f.handleAllowIfResult(res, err)
+}
+
+type bodyFilter struct {
+	*filter
+}
+
+func (f *bodyFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	res, err := f.config.allowIfScript.EvalWithRequestBody(f.callbacks, headers, data)
+	return f.handleAllowIfResult(res, err)
+}
+
+func (f *filter) handleAllowIfResult(res any, err error) api.ResultAction {
+	if err != nil {
+		api.LogErrorf("failed to eval script with request: %v", err)
+		return &api.LocalResponse{Code: 503}
+	}
+
+	allowed := res.(bool)
+	if !allowed {
+		api.LogInfo("celScript rejects request")
+		return &api.LocalResponse{Code: 403}
 	}
 	return api.Continue
 }
@@ -0,0 +1,63 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celscript
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/celscript"
+)
+
+func TestAllowIfWithBody(t *testing.T) {
+	conf := &config{
+		Config: celscript.Config{
+			AllowIf: `request.json_body().role == "admin"`,
+		},
+	}
+	assert.Nil(t, conf.Init(nil))
+
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb)
+	assert.IsType(t, &bodyFilter{}, f)
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.WaitAllData, f.DecodeHeaders(hdr, false))
+
+	res := f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{"role":"admin"}`)), nil)
+	assert.Equal(t, api.Continue, res)
+	res = f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{"role":"guest"}`)), nil)
+	assert.Equal(t, 403, res.(*api.LocalResponse).Code)
+	res = f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{`)), nil)
+	assert.Equal(t, 503, res.(*api.LocalResponse).Code)
+}
+
+func TestAllowIfWithoutBody(t *testing.T) {
+	conf := &config{
+		Config: celscript.Config{
+			AllowIf: `request.header("role") == "admin"`,
+		},
+	}
+	assert.Nil(t, conf.Init(nil))
+
+	// DecodeRequest is not defined so the request body can be skipped
+	f := factory(conf, envoy.NewFilterCallbackHandler())
+	assert.IsType(t, &filter{}, f)
+	hdr := envoy.NewRequestHeaderMap(http.Header{"Role": []string{"admin"}})
+	assert.Equal(t, api.Continue, f.DecodeHeaders(hdr, false))
+}
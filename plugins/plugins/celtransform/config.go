@@ -0,0 +1,138 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celtransform
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
+	"mosn.io/htnn/types/plugins/celtransform"
+)
+
+func init() {
+	plugins.RegisterPlugin(celtransform.Name, &plugin{})
+}
+
+type plugin struct {
+	celtransform.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type headerValue struct {
+	name   string
+	script expr.Script
+}
+
+type headerTransform struct {
+	remove []string
+	rename []*celtransform.HeaderRename
+	set    []*headerValue
+	add    []*headerValue
+}
+
+func (ht *headerTransform) needRequestBody() bool {
+	for _, h := range ht.set {
+		if h.script.NeedRequestBody() {
+			return true
+		}
+	}
+	for _, h := range ht.add {
+		if h.script.NeedRequestBody() {
+			return true
+		}
+	}
+	return false
+}
+
+type config struct {
+	celtransform.Config
+
+	requestHeaders  *headerTransform
+	pathScript      expr.Script
+	responseHeaders *headerTransform
+	needRequestBody bool
+}
+
+func compileHeaderValues(field string, values []*celtransform.HeaderValue) ([]*headerValue, error) {
+	compiled := make([]*headerValue, 0, len(values))
+	for i, v := range values {
+		s, err := expr.CompileCel(v.Value, cel.StringType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s[%d].value: %w", field, i, err)
+		}
+		compiled = append(compiled, &headerValue{
+			name:   v.Name,
+			script: s,
+		})
+	}
+	return compiled, nil
+}
+
+func compileHeaderTransform(field string, ht *celtransform.HeaderTransform) (*headerTransform, error) {
+	if ht == nil {
+		return nil, nil
+	}
+	set, err := compileHeaderValues(field+".set", ht.Set)
+	if err != nil {
+		return nil, err
+	}
+	add, err := compileHeaderValues(field+".add", ht.Add)
+	if err != nil {
+		return nil, err
+	}
+	return &headerTransform{
+		remove: ht.Remove,
+		rename: ht.Rename,
+		set:    set,
+		add:    add,
+	}, nil
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	var err error
+	conf.requestHeaders, err = compileHeaderTransform("request.headers", conf.GetRequest().GetHeaders())
+	if err != nil {
+		return err
+	}
+	if path := conf.GetRequest().GetPath(); path != "" {
+		conf.pathScript, err = expr.CompileCel(path, cel.StringType)
+		if err != nil {
+			return fmt.Errorf("invalid request.path: %w", err)
+		}
+	}
+	conf.responseHeaders, err = compileHeaderTransform("response.headers", conf.GetResponse().GetHeaders())
+	if err != nil {
+		return err
+	}
+
+	if conf.requestHeaders != nil && conf.requestHeaders.needRequestBody() {
+		conf.needRequestBody = true
+	}
+	if conf.pathScript != nil && conf.pathScript.NeedRequestBody() {
+		conf.needRequestBody = true
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celtransform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "empty",
+			input: `{}`,
+		},
+		{
+			name: "valid",
+			input: `{"request":{"path":"\"/v2\" + request.path()","headers":{"set":[{"name":"x-user","value":"consumer.name()"}],
+"remove":["x-internal"],"rename":[{"from":"a","to":"b"}]}},"response":{"headers":{"add":[{"name":"x-status","value":"string(response.status())"}]}}}`,
+		},
+		{
+			name:  "bad return type",
+			input: `{"request":{"headers":{"set":[{"name":"x-user","value":"1"}]}}}`,
+			err:   "invalid request.headers.set[0].value: got int, wanted string",
+		},
+		{
+			name:  "bad path",
+			input: `{"request":{"path":"request.path("}}`,
+			err:   "invalid request.path",
+		},
+		{
+			name:  "bad response header",
+			input: `{"response":{"headers":{"add":[{"name":"x-status","value":"response.status()"}]}}}`,
+			err:   "invalid response.headers.add[0].value",
+		},
+		{
+			name:  "missing header name",
+			input: `{"response":{"headers":{"add":[{"value":"\"a\""}]}}}`,
+			err:   "invalid HeaderValue.Name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+
+				err = conf.Init(nil)
+				assert.Nil(t, err)
+			} else {
+				if err == nil {
+					// the CEL expressions are compiled during initialization
+					err = conf.Init(nil)
+				}
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
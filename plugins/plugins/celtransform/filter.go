@@ -0,0 +1,130 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celtransform
+
+import (
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/pkg/expr"
+)
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+
+	reqHeaders api.RequestHeaderMap
+}
+
+type evalFunc func(s expr.Script) (any, error)
+
+// evalString evaluates the expression. The error is logged and an empty string is returned, so that
+// a broken expression won't stop the other transformations.
+func evalString(name string, eval evalFunc, s expr.Script) string {
+	res, err := eval(s)
+	if err != nil {
+		api.LogErrorf("failed to eval expression for %s: %v", name, err)
+		return ""
+	}
+	return res.(string)
+}
+
+func transformHeaders(ht *headerTransform, headers api.HeaderMap, eval evalFunc) {
+	if ht == nil {
+		return
+	}
+
+	for _, name := range ht.remove {
+		headers.Del(name)
+	}
+	for _, r := range ht.rename {
+		values := headers.Values(r.From)
+		if len(values) == 0 {
+			continue
+		}
+		headers.Del(r.From)
+		headers.Del(r.To)
+		for _, v := range values {
+			headers.Add(r.To, v)
+		}
+	}
+	// Evaluate all the values before changing the headers, so that the expressions see the
+	// same headers no matter their order
+	setValues := make([]string, len(ht.set))
+	for i, h := range ht.set {
+		setValues[i] = evalString(h.name, eval, h.script)
+	}
+	addValues := make([]string, len(ht.add))
+	for i, h := range ht.add {
+		addValues[i] = evalString(h.name, eval, h.script)
+	}
+	for i, h := range ht.set {
+		if setValues[i] != "" {
+			headers.Set(h.name, setValues[i])
+		}
+	}
+	for i, h := range ht.add {
+		if addValues[i] != "" {
+			headers.Add(h.name, addValues[i])
+		}
+	}
+}
+
+func (f *filter) transformRequest(headers api.RequestHeaderMap, body api.BufferInstance) {
+	eval := func(s expr.Script) (any, error) {
+		return s.EvalWithRequestBody(f.callbacks, headers, body)
+	}
+
+	config := f.config
+	// Compute the path with the original request
+	path := ""
+	if config.pathScript != nil {
+		path = evalString(":path", eval, config.pathScript)
+	}
+	transformHeaders(config.requestHeaders, headers, eval)
+	if path != "" {
+		headers.SetPath(path)
+	}
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	f.reqHeaders = headers
+	if f.config.needRequestBody && !endStream {
+		return api.WaitAllData
+	}
+
+	f.transformRequest(headers, nil)
+	return api.Continue
+}
+
+func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	f.transformRequest(headers, data)
+	return api.Continue
+}
+
+func (f *filter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	eval := func(s expr.Script) (any, error) {
+		return s.EvalWithResponse(f.callbacks, f.reqHeaders, headers)
+	}
+	transformHeaders(f.config.responseHeaders, headers, eval)
+	return api.Continue
+}
@@ -0,0 +1,136 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celtransform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+)
+
+type testConsumer struct {
+	name string
+}
+
+func (c *testConsumer) Name() string {
+	return c.name
+}
+
+func (c *testConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
+func (c *testConsumer) Metadata() map[string]string {
+	return map[string]string{"tier": "gold"}
+}
+
+func newConfig(t *testing.T, input string) *config {
+	conf := &config{}
+	err := protojson.Unmarshal([]byte(input), conf)
+	assert.Nil(t, err)
+	assert.Nil(t, conf.Validate())
+	assert.Nil(t, conf.Init(nil))
+	return conf
+}
+
+func TestTransform(t *testing.T) {
+	conf := newConfig(t, `{
+		"request": {
+			"path": "\"/v2\" + request.path()",
+			"headers": {
+				"remove": ["x-internal"],
+				"rename": [{"from": "x-old", "to": "x-new"}],
+				"set": [
+					{"name": "x-user", "value": "consumer.name()"},
+					{"name": "x-from-state", "value": "plugin_state.get(\"auth\", \"user\")"},
+					{"name": "x-empty", "value": "consumer.label(\"unknown\")"}
+				],
+				"add": [{"name": "x-path", "value": "request.path()"}]
+			}
+		},
+		"response": {
+			"headers": {
+				"set": [{"name": "x-tier", "value": "consumer.label(\"tier\")"}],
+				"add": [{"name": "x-req", "value": "request.header(\"x-user\") + \":\" + string(response.status())"}]
+			}
+		}
+	}`)
+
+	cb := envoy.NewFilterCallbackHandler()
+	cb.SetConsumer(&testConsumer{name: "leo"})
+	cb.PluginState().Set("auth", "user", "state-user")
+	f := factory(conf, cb)
+
+	hdr := http.Header{}
+	hdr.Set(":path", "/echo?a=1")
+	hdr.Set("x-internal", "1")
+	hdr.Add("x-old", "a")
+	hdr.Add("x-old", "b")
+	reqHdr := envoy.NewRequestHeaderMap(hdr)
+	assert.Equal(t, api.Continue, f.DecodeHeaders(reqHdr, true))
+
+	assert.Equal(t, "/v2/echo?a=1", reqHdr.Path())
+	_, ok := reqHdr.Get("x-internal")
+	assert.False(t, ok)
+	_, ok = reqHdr.Get("x-old")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a", "b"}, reqHdr.Values("x-new"))
+	v, _ := reqHdr.Get("x-user")
+	assert.Equal(t, "leo", v)
+	v, _ = reqHdr.Get("x-from-state")
+	assert.Equal(t, "state-user", v)
+	// empty value is not set
+	_, ok = reqHdr.Get("x-empty")
+	assert.False(t, ok)
+	// the expressions are evaluated with the original request
+	v, _ = reqHdr.Get("x-path")
+	assert.Equal(t, "/echo?a=1", v)
+
+	respHdr := envoy.NewResponseHeaderMap(http.Header{":status": []string{"201"}})
+	assert.Equal(t, api.Continue, f.EncodeHeaders(respHdr, true))
+	v, _ = respHdr.Get("x-tier")
+	assert.Equal(t, "gold", v)
+	v, _ = respHdr.Get("x-req")
+	assert.Equal(t, "leo:201", v)
+}
+
+func TestTransformWithBody(t *testing.T) {
+	conf := newConfig(t, `{
+		"request": {
+			"headers": {
+				"set": [{"name": "x-user", "value": "string(request.json_body().user)"}]
+			}
+		}
+	}`)
+
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb)
+	reqHdr := envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.WaitAllData, f.DecodeHeaders(reqHdr, false))
+	assert.Equal(t, api.Continue, f.DecodeRequest(reqHdr, envoy.NewBufferInstance([]byte(`{"user":"leo"}`)), nil))
+	v, _ := reqHdr.Get("x-user")
+	assert.Equal(t, "leo", v)
+
+	// the broken expression is skipped
+	reqHdr = envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.Continue, f.DecodeRequest(reqHdr, envoy.NewBufferInstance([]byte(`{}`)), nil))
+	_, ok := reqHdr.Get("x-user")
+	assert.False(t, ok)
+}
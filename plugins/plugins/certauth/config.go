@@ -0,0 +1,57 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certauth
+
+import (
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/certauth"
+)
+
+func init() {
+	plugins.RegisterPlugin(certauth.Name, &plugin{})
+}
+
+type plugin struct {
+	certauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+type config struct {
+	certauth.Config
+
+	identities []certauth.Identity
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	conf.identities = conf.Identities
+	if len(conf.identities) == 0 {
+		// from the most specific to the least specific
+		conf.identities = []certauth.Identity{
+			certauth.Identity_SHA256_FINGERPRINT,
+			certauth.Identity_URI_SAN,
+			certauth.Identity_DNS_SAN,
+			certauth.Identity_SUBJECT,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/types/plugins/certauth"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "empty",
+			input: `{}`,
+		},
+		{
+			name:  "duplicate identities",
+			input: `{"identities":["SUBJECT","SUBJECT"]}`,
+			err:   "invalid Config.Identities[1]: repeated value must contain unique items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConsumerConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		index string
+		err   string
+	}{
+		{
+			name:  "subject",
+			input: `{"subject":"CN=partner,O=example"}`,
+			index: "subject:CN=partner,O=example",
+		},
+		{
+			name:  "uri san",
+			input: `{"uriSan":"spiffe://example.com/partner"}`,
+			index: "uri:spiffe://example.com/partner",
+		},
+		{
+			name:  "dns san",
+			input: `{"dnsSan":"partner.example.com"}`,
+			index: "dns:partner.example.com",
+		},
+		{
+			name:  "fingerprint",
+			input: `{"sha256Fingerprint":"9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08"}`,
+			index: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		},
+		{
+			name:  "identity is required",
+			input: `{}`,
+			err:   "invalid ConsumerConfig.Identity: value is required",
+		},
+		{
+			name:  "invalid fingerprint",
+			input: `{"sha256Fingerprint":"9f86d081"}`,
+			err:   "invalid ConsumerConfig.Sha256Fingerprint",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &certauth.ConsumerConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.index, conf.Index())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certauth
+
+import (
+	"errors"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/certauth"
+)
+
+// See https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes#connection-attributes
+var identityAttributes = map[certauth.Identity]string{
+	certauth.Identity_SHA256_FINGERPRINT: "connection.sha256_peer_certificate_digest",
+	certauth.Identity_URI_SAN:            "connection.uri_san_peer_certificate",
+	certauth.Identity_DNS_SAN:            "connection.dns_san_peer_certificate",
+	certauth.Identity_SUBJECT:            "connection.subject_peer_certificate",
+}
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	hasCert := false
+	for _, id := range f.config.identities {
+		value, err := f.callbacks.GetProperty(identityAttributes[id])
+		if err != nil {
+			if !errors.Is(err, api.ErrValueNotFound) {
+				api.LogErrorf("failed to get %s of the client certificate: %v", id, err)
+			}
+			continue
+		}
+		if value == "" {
+			continue
+		}
+
+		hasCert = true
+		c, ok := f.callbacks.LookupConsumer(certauth.Name, certauth.IndexOf(id, value))
+		if ok {
+			f.callbacks.SetConsumer(c)
+			return api.Continue
+		}
+	}
+
+	if hasCert {
+		return &api.LocalResponse{Code: 401, Msg: "unknown client certificate"}
+	}
+	// no client certificate, let other Authn plugins try
+	return api.Continue
+}
@@ -0,0 +1,176 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certauth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/certauth"
+)
+
+const fingerprint = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestCertAuth(t *testing.T) {
+	consumers := map[string]*certauth.ConsumerConfig{}
+	for _, c := range []*certauth.ConsumerConfig{
+		{Identity: &certauth.ConsumerConfig_Sha256Fingerprint{Sha256Fingerprint: "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08"}},
+		{Identity: &certauth.ConsumerConfig_UriSan{UriSan: "spiffe://example.com/partner"}},
+		{Identity: &certauth.ConsumerConfig_DnsSan{DnsSan: "partner.example.com"}},
+		{Identity: &certauth.ConsumerConfig_Subject{Subject: "CN=partner,O=example"}},
+	} {
+		consumers[c.Index()] = c
+	}
+
+	tests := []struct {
+		name     string
+		conf     string
+		props    map[string]string
+		status   int
+		consumer string
+	}{
+		{
+			name: "no client certificate",
+		},
+		{
+			name: "fingerprint",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": fingerprint,
+				"connection.subject_peer_certificate":       "CN=partner,O=example",
+			},
+			consumer: "sha256:" + fingerprint,
+		},
+		{
+			name: "uri san",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.uri_san_peer_certificate":       "spiffe://example.com/partner",
+			},
+			consumer: "uri:spiffe://example.com/partner",
+		},
+		{
+			name: "dns san",
+			props: map[string]string{
+				"connection.dns_san_peer_certificate": "partner.example.com",
+			},
+			consumer: "dns:partner.example.com",
+		},
+		{
+			name: "subject",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.subject_peer_certificate":       "CN=partner,O=example",
+			},
+			consumer: "subject:CN=partner,O=example",
+		},
+		{
+			name: "configured identities",
+			conf: `{"identities":["SUBJECT"]}`,
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": fingerprint,
+				"connection.subject_peer_certificate":       "CN=partner,O=example",
+			},
+			consumer: "subject:CN=partner,O=example",
+		},
+		{
+			name: "identity not configured",
+			conf: `{"identities":["SUBJECT"]}`,
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": fingerprint,
+				"connection.subject_peer_certificate":       "CN=unknown",
+			},
+			status: 401,
+		},
+		{
+			name: "unknown certificate",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.subject_peer_certificate":       "CN=unknown",
+			},
+			status: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			conf := &config{}
+			input := tt.conf
+			if input == "" {
+				input = "{}"
+			}
+			require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+			require.NoError(t, conf.Validate())
+			require.NoError(t, conf.Init(nil))
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+				c, ok := consumers[key]
+				if !ok {
+					return nil, false
+				}
+				return consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+					certauth.Name: c,
+				}), true
+			})
+			defer patches.Reset()
+			patches.ApplyMethodFunc(cb, "GetProperty", func(key string) (string, error) {
+				v, ok := tt.props[key]
+				if !ok {
+					return "", api.ErrValueNotFound
+				}
+				return v, nil
+			})
+
+			res := f.DecodeHeaders(envoy.NewRequestHeaderMap(nil), true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+				return
+			}
+
+			assert.Equal(t, api.Continue, res)
+			if tt.consumer == "" {
+				assert.Nil(t, cb.GetConsumer())
+			} else {
+				require.NotNil(t, cb.GetConsumer())
+				assert.Equal(t, tt.consumer, cb.GetConsumer().PluginConfig(certauth.Name).Index())
+			}
+		})
+	}
+}
+
+func TestCertAuthGetPropertyFailed(t *testing.T) {
+	cb := envoy.NewFilterCallbackHandler()
+	conf := &config{}
+	require.NoError(t, conf.Init(nil))
+	f := factory(conf, cb)
+
+	patches := gomonkey.ApplyMethodReturn(cb, "GetProperty", "", errors.New("internal failure"))
+	defer patches.Reset()
+
+	res := f.DecodeHeaders(envoy.NewRequestHeaderMap(nil), true)
+	assert.Equal(t, api.Continue, res)
+	assert.Nil(t, cb.GetConsumer())
+}
@@ -15,8 +15,14 @@
 package consumerrestriction
 
 import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/cel-go/cel"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
 	"mosn.io/htnn/types/plugins/consumerrestriction"
 )
 
@@ -37,38 +43,130 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	consumerrestriction.Config
+	consumerrestriction.CustomConfig
 
-	allow     bool
-	consumers map[string]*Rule
+	// the first matched rule decides whether to allow the request
+	rules        []*rule
+	defaultAllow bool
+
+	needRequestBody    bool
+	denyResponse       *api.LocalResponse
+	noConsumerResponse *api.LocalResponse
 }
 
-type Rule struct {
-	Name    string
-	Methods map[string]bool
+type rule struct {
+	allow bool
+
+	name    string
+	methods map[string]bool
+	paths   expr.Matcher
+	hosts   expr.Matcher
+	headers []*expr.HeaderMatcher
+	script  expr.Script
+}
+
+func buildRule(r *consumerrestriction.Rule, allow bool) (*rule, error) {
+	built := &rule{
+		allow: allow,
+		name:  r.Name,
+	}
+
+	if len(r.Methods) > 0 {
+		built.methods = make(map[string]bool, len(r.Methods))
+		for _, method := range r.Methods {
+			built.methods[method] = true
+		}
+	}
+
+	var err error
+	if len(r.Paths) > 0 {
+		built.paths, err = expr.BuildRepeatedStringMatcher(r.Paths)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(r.Hosts) > 0 {
+		built.hosts, err = expr.BuildRepeatedStringMatcherIgnoreCase(r.Hosts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, h := range r.Headers {
+		hm, err := expr.BuildHeaderMatcher(h)
+		if err != nil {
+			return nil, err
+		}
+		built.headers = append(built.headers, hm)
+	}
+	if r.Expr != "" {
+		built.script, err = expr.CompileCel(r.Expr, cel.BoolType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expr: %w", err)
+		}
+	}
+	return built, nil
+}
+
+func buildDenyResponse(resp *consumerrestriction.DenyResponse, defaultCode int, defaultMsg string) *api.LocalResponse {
+	lr := &api.LocalResponse{Code: defaultCode, Msg: defaultMsg}
+	if resp == nil {
+		return lr
+	}
+	if resp.StatusCode != 0 {
+		lr.Code = int(resp.StatusCode)
+	}
+	if resp.Message != "" {
+		lr.Msg = resp.Message
+	}
+	if len(resp.Headers) > 0 {
+		lr.Header = make(http.Header, len(resp.Headers))
+		for k, v := range resp.Headers {
+			lr.Header.Set(k, v)
+		}
+	}
+	return lr
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	conf.denyResponse = buildDenyResponse(conf.GetDenyResponse(), 403, "consumer not allowed")
+	conf.noConsumerResponse = buildDenyResponse(conf.GetDenyResponse(), 401, "consumer not found")
+
 	if conf.GetDenyIfNoConsumer() {
 		return nil
 	}
 
-	rules := conf.GetDeny()
-	if rules == nil {
-		rules = conf.GetAllow()
-		conf.allow = true
+	type ruleWithAction struct {
+		rule  *consumerrestriction.Rule
+		allow bool
+	}
+	var rules []ruleWithAction
+	if allow := conf.GetAllow(); allow != nil {
+		for _, r := range allow.Rules {
+			rules = append(rules, ruleWithAction{rule: r, allow: true})
+		}
+	} else if deny := conf.GetDeny(); deny != nil {
+		for _, r := range deny.Rules {
+			rules = append(rules, ruleWithAction{rule: r, allow: false})
+		}
+		conf.defaultAllow = true
+	} else {
+		ordered := conf.GetOrdered()
+		for _, r := range ordered.Rules {
+			rules = append(rules, ruleWithAction{rule: r.Match, allow: r.Action == consumerrestriction.Action_ALLOW})
+		}
+		conf.defaultAllow = ordered.DefaultAction == consumerrestriction.Action_ALLOW
 	}
 
-	conf.consumers = make(map[string]*Rule, len(rules.Rules))
-	for _, r := range rules.Rules {
-		methods := make(map[string]bool)
-		for _, method := range r.GetMethods() {
-			methods[method] = true
+	conf.rules = make([]*rule, 0, len(rules))
+	for i, r := range rules {
+		built, err := buildRule(r.rule, r.allow)
+		if err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
 		}
-		conf.consumers[r.Name] = &Rule{
-			Name:    r.Name,
-			Methods: methods,
+		if built.script != nil && built.script.NeedRequestBody() {
+			conf.needRequestBody = true
 		}
+		conf.rules = append(conf.rules, built)
 	}
 	return nil
 }
@@ -39,6 +39,26 @@ func TestBadConfig(t *testing.T) {
 			}`,
 			err: "oneof types.plugins.consumerrestriction.Config.config_type is already set",
 		},
+		{
+			name:  "invalid path regex",
+			input: `{"allow":{"rules":[{"name":"A","paths":[{"regex":"(?!)a"}]}]}}`,
+			err:   "invalid rule 0: invalid paths",
+		},
+		{
+			name:  "invalid header range",
+			input: `{"ordered":{"rules":[{"match":{"name":"A"}},{"match":{"headers":[{"name":"x","range_match":{"start":1,"end":0}}]}}]}}`,
+			err:   "invalid rule 1: invalid headers[0]: invalid range [1, 0)",
+		},
+		{
+			name:  "match is required",
+			input: `{"ordered":{"rules":[{"action":"DENY"}]}}`,
+			err:   "invalid OrderedRule.Match: value is required",
+		},
+		{
+			name:  "bad expr",
+			input: `{"deny":{"rules":[{"name":"A","expr":"request.path()"}]}}`,
+			err:   "invalid rule 0: invalid expr: got string, wanted bool",
+		},
 	}
 
 	for _, tt := range tests {
@@ -48,6 +68,10 @@ func TestBadConfig(t *testing.T) {
 			if err == nil {
 				err = conf.Validate()
 			}
+			if err == nil {
+				// the CEL expressions are compiled during initialization
+				err = conf.Init(nil)
+			}
 			assert.NotNil(t, err)
 			assert.ErrorContains(t, err, tt.err)
 		})
@@ -19,10 +19,16 @@ import (
 )
 
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
-	return &filter{
+	f := &filter{
 		callbacks: callbacks,
 		config:    c.(*config),
 	}
+	// Defining DecodeRequest makes the request body go through the filter manager, so only
+	// use it when a rule reads the body.
+	if f.config.needRequestBody {
+		return &bodyFilter{filter: f}
+	}
+	return f
 }
 
 type filter struct {
@@ -32,25 +38,93 @@ type filter struct {
 	config    *config
 }
 
+func (r *rule) match(f *filter, consumerName string, headers api.RequestHeaderMap, body api.BufferInstance) (bool, error) {
+	if r.name != "" && r.name != consumerName {
+		return false, nil
+	}
+	if r.methods != nil && !r.methods[headers.Method()] {
+		return false, nil
+	}
+	if r.paths != nil {
+		u := headers.URL()
+		if u == nil || !r.paths.Match(u.Path) {
+			return false, nil
+		}
+	}
+	if r.hosts != nil && !r.hosts.Match(headers.Host()) {
+		return false, nil
+	}
+	for _, hm := range r.headers {
+		if !hm.Match(headers) {
+			return false, nil
+		}
+	}
+	if r.script != nil {
+		var res any
+		var err error
+		if r.script.NeedRequestBody() {
+			res, err = r.script.EvalWithRequestBody(f.callbacks, headers, body)
+		} else {
+			res, err = r.script.EvalWithRequest(f.callbacks, headers)
+		}
+		if err != nil {
+			return false, err
+		}
+		return res.(bool), nil
+	}
+	return true, nil
+}
+
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
 	consumer := f.callbacks.GetConsumer()
 	if consumer == nil {
 		api.LogInfo("consumerRestriction: consumer not found")
-		return &api.LocalResponse{Code: 401, Msg: "consumer not found"}
+		return copyResponse(f.config.noConsumerResponse)
 	}
 
 	if f.config.GetDenyIfNoConsumer() {
 		return api.Continue
 	}
 
-	consumerName := consumer.Name()
-	rule, ok := f.config.consumers[consumerName]
-	methodMatched := ok && (len(rule.Methods) == 0 || rule.Methods[headers.Method()])
+	if f.config.needRequestBody && !endStream {
+		return api.WaitAllData
+	}
+	return f.checkAccess(consumer.Name(), headers, nil)
+}
+
+type bodyFilter struct {
+	*filter
+}
+
+func (f *bodyFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	return f.checkAccess(f.callbacks.GetConsumer().Name(), headers, data)
+}
 
-	if methodMatched != f.config.allow {
+func (f *filter) checkAccess(consumerName string, headers api.RequestHeaderMap, body api.BufferInstance) api.ResultAction {
+	allowed := f.config.defaultAllow
+	for _, r := range f.config.rules {
+		matched, err := r.match(f, consumerName, headers, body)
+		if err != nil {
+			api.LogErrorf("consumerRestriction: failed to eval script with request: %v", err)
+			return &api.LocalResponse{Code: 503}
+		}
+		if matched {
+			allowed = r.allow
+			break
+		}
+	}
+
+	if !allowed {
 		api.LogInfof("consumerRestriction: consumer %s not allowed", consumerName)
-		return &api.LocalResponse{Code: 403, Msg: "consumer not allowed"}
+		return copyResponse(f.config.denyResponse)
 	}
 
 	return api.Continue
 }
+
+// copyResponse copies the configured response, as the filter manager may modify the returned one
+func copyResponse(lr *api.LocalResponse) *api.LocalResponse {
+	resp := *lr
+	resp.Header = resp.Header.Clone()
+	return &resp
+}
@@ -0,0 +1,207 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumerrestriction
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+)
+
+type testConsumer struct {
+	name string
+}
+
+func (c *testConsumer) Name() string {
+	return c.name
+}
+
+func (c *testConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
+func (c *testConsumer) Metadata() map[string]string {
+	return nil
+}
+
+func newConfig(t *testing.T, input string) *config {
+	conf := &config{}
+	err := protojson.Unmarshal([]byte(input), conf)
+	assert.Nil(t, err)
+	assert.Nil(t, conf.Validate())
+	assert.Nil(t, conf.Init(nil))
+	return conf
+}
+
+func TestRules(t *testing.T) {
+	type request struct {
+		consumer string
+		headers  http.Header
+		code     int
+	}
+
+	tests := []struct {
+		name     string
+		config   string
+		requests []request
+	}{
+		{
+			name:   "allow",
+			config: `{"allow":{"rules":[{"name":"A","methods":["GET"]},{"name":"B"}]}}`,
+			requests: []request{
+				{consumer: "A", headers: http.Header{":method": []string{"GET"}}},
+				{consumer: "A", headers: http.Header{":method": []string{"POST"}}, code: 403},
+				{consumer: "B", headers: http.Header{":method": []string{"POST"}}},
+				{consumer: "C", headers: http.Header{":method": []string{"GET"}}, code: 403},
+			},
+		},
+		{
+			name:   "deny",
+			config: `{"deny":{"rules":[{"name":"A"}]}}`,
+			requests: []request{
+				{consumer: "A", code: 403},
+				{consumer: "B"},
+			},
+		},
+		{
+			name: "allow with path, host and header",
+			config: `{"allow":{"rules":[
+				{"name":"A","methods":["GET"],"paths":[{"prefix":"/orders/"}]},
+				{"name":"B","methods":["POST"],"paths":[{"regex":"^/orders/[0-9]+$"}]},
+				{"hosts":[{"exact":"admin.example.com"}],"headers":[{"name":"x-admin","string_match":{"exact":"true"}}]}
+			]}}`,
+			requests: []request{
+				{consumer: "A", headers: http.Header{":method": []string{"GET"}, ":path": []string{"/orders/1?x=1"}}},
+				{consumer: "A", headers: http.Header{":method": []string{"POST"}, ":path": []string{"/orders/1"}}, code: 403},
+				{consumer: "B", headers: http.Header{":method": []string{"POST"}, ":path": []string{"/orders/1"}}},
+				{consumer: "B", headers: http.Header{":method": []string{"POST"}, ":path": []string{"/orders/a"}}, code: 403},
+				{consumer: "C", headers: http.Header{":authority": []string{"Admin.example.com"}, "X-Admin": []string{"true"}}},
+				{consumer: "C", headers: http.Header{":authority": []string{"admin.example.com"}}, code: 403},
+			},
+		},
+		{
+			name: "ordered",
+			config: `{"ordered":{"rules":[
+				{"action":"ALLOW","match":{"name":"B","methods":["POST"],"paths":[{"prefix":"/orders/"}]}},
+				{"action":"DENY","match":{"methods":["POST"],"paths":[{"prefix":"/orders/"}]}},
+				{"action":"DENY","match":{"expr":"request.header(\"x-block\") == \"true\""}}
+			],"defaultAction":"ALLOW"},"denyResponse":{"statusCode":404,"message":"not found","headers":{"x-reason":"restricted"}}}`,
+			requests: []request{
+				{consumer: "A", headers: http.Header{":method": []string{"GET"}, ":path": []string{"/orders/1"}}},
+				{consumer: "A", headers: http.Header{":method": []string{"POST"}, ":path": []string{"/orders/1"}}, code: 404},
+				{consumer: "B", headers: http.Header{":method": []string{"POST"}, ":path": []string{"/orders/1"}}},
+				{consumer: "B", headers: http.Header{"X-Block": []string{"true"}}, code: 404},
+			},
+		},
+		{
+			name:   "ordered, default deny",
+			config: `{"ordered":{"rules":[{"action":"ALLOW","match":{"name":"A"}}]}}`,
+			requests: []request{
+				{consumer: "A"},
+				{consumer: "B", code: 403},
+			},
+		},
+		{
+			name:   "ordered, rule without action",
+			config: `{"ordered":{"rules":[{"match":{"name":"A"}}],"defaultAction":"ALLOW"}}`,
+			requests: []request{
+				{consumer: "A", code: 403},
+				{consumer: "B"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := newConfig(t, tt.config)
+			for _, req := range tt.requests {
+				cb := envoy.NewFilterCallbackHandler()
+				cb.SetConsumer(&testConsumer{name: req.consumer})
+				f := factory(conf, cb)
+				// DecodeRequest is not defined so the request body can be skipped
+				assert.IsType(t, &filter{}, f)
+				hdrs := req.headers
+				if hdrs == nil {
+					hdrs = http.Header{}
+				}
+				res := f.DecodeHeaders(envoy.NewRequestHeaderMap(hdrs), true)
+				if req.code == 0 {
+					assert.Equal(t, api.Continue, res, req)
+				} else {
+					lr, ok := res.(*api.LocalResponse)
+					if assert.True(t, ok, req) {
+						assert.Equal(t, req.code, lr.Code, req)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDenyResponse(t *testing.T) {
+	conf := newConfig(t, `{"deny":{"rules":[{"name":"A"}]},"denyResponse":{"statusCode":404,"message":"not found","headers":{"x-reason":"restricted"}}}`)
+	cb := envoy.NewFilterCallbackHandler()
+	cb.SetConsumer(&testConsumer{name: "A"})
+	f := factory(conf, cb)
+	res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	lr := res.(*api.LocalResponse)
+	assert.Equal(t, 404, lr.Code)
+	assert.Equal(t, "not found", lr.Msg)
+	assert.Equal(t, "restricted", lr.Header.Get("x-reason"))
+
+	// the response returned by the previous request is modified
+	lr.Header.Set("Content-Type", "application/json")
+	f = factory(conf, cb)
+	res = f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	lr = res.(*api.LocalResponse)
+	assert.Equal(t, http.Header{"X-Reason": []string{"restricted"}}, lr.Header)
+	assert.Equal(t, http.Header{"X-Reason": []string{"restricted"}}, conf.denyResponse.Header)
+
+	// also apply to the request without consumer
+	f = factory(conf, envoy.NewFilterCallbackHandler())
+	res = f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	lr = res.(*api.LocalResponse)
+	assert.Equal(t, 404, lr.Code)
+	assert.Equal(t, "not found", lr.Msg)
+	assert.Equal(t, "restricted", lr.Header.Get("x-reason"))
+
+	conf = newConfig(t, `{"deny":{"rules":[{"name":"A"}]}}`)
+	f = factory(conf, cb)
+	res = f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	assert.Equal(t, &api.LocalResponse{Code: 403, Msg: "consumer not allowed"}, res)
+	f = factory(conf, envoy.NewFilterCallbackHandler())
+	res = f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	assert.Equal(t, &api.LocalResponse{Code: 401, Msg: "consumer not found"}, res)
+}
+
+func TestRuleWithBody(t *testing.T) {
+	conf := newConfig(t, `{"allow":{"rules":[{"expr":"request.json_body().role == \"admin\""}]}}`)
+	cb := envoy.NewFilterCallbackHandler()
+	cb.SetConsumer(&testConsumer{name: "A"})
+	f := factory(conf, cb)
+	assert.IsType(t, &bodyFilter{}, f)
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.WaitAllData, f.DecodeHeaders(hdr, false))
+	assert.Equal(t, api.Continue, f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{"role":"admin"}`)), nil))
+	res := f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{"role":"guest"}`)), nil)
+	assert.Equal(t, 403, res.(*api.LocalResponse).Code)
+	res = f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(`{`)), nil)
+	assert.Equal(t, 503, res.(*api.LocalResponse).Code)
+}
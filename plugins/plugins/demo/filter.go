@@ -17,8 +17,9 @@ package demo
 import (
 	"fmt"
 
+	"mosn.io/htnn/api/pkg/dynamicconfig"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
-	"mosn.io/htnn/plugins/dynamicconfigs/demo"
+	"mosn.io/htnn/types/dynamicconfigs/demo"
 )
 
 // factory returns a per-request Filter which has configuration bound to it.
@@ -54,9 +55,10 @@ func (f *filter) hello() string {
 }
 
 func (f *filter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
-	k := demo.GetDemoKey()
-	if k != "" {
-		headers.Add("DemoKey", k)
+	// The config got from Get is an immutable snapshot, so it's safe to read it without locking
+	c, _, ok := dynamicconfig.Get[*demo.Config]("demo", f.callbacks.Namespace())
+	if ok && c.Key != "" {
+		headers.Add("DemoKey", c.Key)
 	}
 	return api.Continue
 }
@@ -15,9 +15,19 @@
 package extauth
 
 import (
+	"crypto/tls"
+	"fmt"
 	"net/http"
+	"runtime"
 	"time"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/google/cel-go/cel"
+	"github.com/jellydator/ttlcache/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/types/pkg/expr"
@@ -41,14 +51,76 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	extauth.Config
+	extauth.CustomConfig
 
 	client                  *http.Client
 	headerToUpstreamMatcher expr.Matcher
 	headerToClientMatcher   expr.Matcher
+	headerToAuthzMatcher    expr.Matcher
+
+	cacheKey  expr.Script
+	decisions *ttlcache.Cache[string, *decision]
+
+	grpcConn    *grpc.ClientConn
+	grpcClient  authv3.AuthorizationClient
+	grpcTimeout time.Duration
+}
+
+func (conf *config) initGrpcClient(gs *extauth.GrpcService) error {
+	conf.grpcTimeout = 200 * time.Millisecond
+	if gs.Timeout != nil {
+		conf.grpcTimeout = gs.Timeout.AsDuration()
+	}
+
+	creds := insecure.NewCredentials()
+	if gs.Tls {
+		creds = credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: gs.TlsSkipVerify,
+		})
+	}
+	// The connection is established lazily, so the creation won't block
+	conn, err := grpc.NewClient(gs.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	conf.grpcConn = conn
+	conf.grpcClient = authv3.NewAuthorizationClient(conn)
+	runtime.SetFinalizer(conf, func(conf *config) {
+		api.LogInfof("close grpc connection in extAuth conf: %+v", conf)
+		conf.grpcConn.Close()
+	})
+	return nil
+}
+
+func (conf *config) initCache(c *extauth.Cache) error {
+	s, err := expr.CompileCel(c.Key, cel.StringType)
+	if err != nil {
+		return fmt.Errorf("invalid cache key: %w", err)
+	}
+	conf.cacheKey = s
+
+	maxEntries := uint64(10000)
+	if c.MaxEntries > 0 {
+		maxEntries = uint64(c.MaxEntries)
+	}
+	// The TTL is decided by each authorization response, so we don't extend it on hit
+	conf.decisions = ttlcache.New(
+		ttlcache.WithCapacity[string, *decision](maxEntries),
+		ttlcache.WithDisableTouchOnHit[string, *decision](),
+	)
+	go conf.decisions.Start()
+	runtime.SetFinalizer(conf, func(conf *config) {
+		api.LogInfof("stop cache in extAuth conf: %+v", conf)
+		conf.decisions.Stop()
+	})
+	return nil
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if gs := conf.GetGrpcService(); gs != nil {
+		return conf.initGrpcClient(gs)
+	}
+
 	du := 200 * time.Millisecond
 	timeout := conf.GetHttpService().GetTimeout()
 	if timeout != nil {
@@ -57,6 +129,21 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 
 	conf.client = &http.Client{Timeout: du}
 
+	if c := conf.GetHttpService().GetCache(); c != nil {
+		if err := conf.initCache(c); err != nil {
+			return err
+		}
+	}
+
+	allowedHeaders := conf.GetHttpService().GetAuthorizationRequest().GetAllowedHeaders()
+	if len(allowedHeaders) > 0 {
+		matcher, err := expr.BuildRepeatedStringMatcherIgnoreCase(allowedHeaders)
+		if err != nil {
+			return err
+		}
+		conf.headerToAuthzMatcher = matcher
+	}
+
 	resp := conf.GetHttpService().GetAuthorizationResponse()
 	if resp != nil {
 		if len(resp.AllowedUpstreamHeaders) > 0 {
@@ -30,6 +30,16 @@ func TestConfTimeout(t *testing.T) {
 	protojson.Unmarshal([]byte(s), conf)
 	conf.Init(nil)
 	assert.Equal(t, 10*time.Second, conf.client.Timeout)
+
+	s = `{"grpcService":{
+		"address": "127.0.0.1:9001",
+		"timeout": "10s"
+	}}`
+	conf = &config{}
+	protojson.Unmarshal([]byte(s), conf)
+	conf.Init(nil)
+	assert.Equal(t, 10*time.Second, conf.grpcTimeout)
+	assert.NotNil(t, conf.grpcClient)
 }
 
 func TestBadConfig(t *testing.T) {
@@ -53,6 +63,31 @@ func TestBadConfig(t *testing.T) {
 			input: `{"httpService":{"url":"http://127.0.0.1","timeout":"-1s"}}`,
 			err:   "invalid HttpService.Timeout: value must be greater than 0s",
 		},
+		{
+			name:  "invalid GrpcService.Address",
+			input: `{"grpcService":{"address":"127.0.0.1"}}`,
+			err:   "bad address 127.0.0.1",
+		},
+		{
+			name:  "GrpcService.Address is required",
+			input: `{"grpcService":{}}`,
+			err:   "invalid GrpcService.Address",
+		},
+		{
+			name:  "invalid Cache.Key",
+			input: `{"httpService":{"url":"http://127.0.0.1","cache":{"key":"request.path() +"}}}`,
+			err:   "invalid cache key",
+		},
+		{
+			name:  "Cache.Ttl greater than Cache.MaxTtl",
+			input: `{"httpService":{"url":"http://127.0.0.1","cache":{"key":"request.path()","ttl":"20s","maxTtl":"10s"}}}`,
+			err:   "cache ttl should not be greater than max_ttl",
+		},
+		{
+			name:  "invalid AuthorizationRequest.ClientIpHeader",
+			input: `{"httpService":{"url":"http://127.0.0.1","authorizationRequest":{"clientIpHeader":"a b"}}}`,
+			err:   "invalid AuthorizationRequest.ClientIpHeader",
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,6 +97,9 @@ func TestBadConfig(t *testing.T) {
 			if err == nil {
 				err = conf.Validate()
 			}
+			if err == nil {
+				err = conf.Init(nil)
+			}
 			assert.NotNil(t, err)
 			assert.ErrorContains(t, err, tt.err)
 		})
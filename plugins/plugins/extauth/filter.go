@@ -16,18 +16,36 @@ package extauth
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/extauth"
 )
 
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
-	return &filter{
+	f := &filter{
 		callbacks: callbacks,
 		config:    c.(*config),
 	}
+	// Only the gRPC authorization service can add headers to the response. Don't define
+	// EncodeHeaders in the HTTP mode so that it can be skipped.
+	if f.config.grpcClient != nil {
+		return &grpcFilter{filter: f}
+	}
+	return f
 }
 
 type filter struct {
@@ -35,35 +53,179 @@ type filter struct {
 
 	callbacks api.FilterCallbackHandler
 	config    *config
+
+	responseHeadersToAdd []*corev3.HeaderValueOption
+}
+
+func (f *filter) onCheckFailure(headers api.RequestHeaderMap, statusOnError int32) api.ResultAction {
+	if f.config.GetFailureModeAllow() {
+		if f.config.GetFailureModeAllowHeaderAdd() {
+			headers.Set("x-envoy-auth-failure-mode-allowed", "true")
+		}
+		return api.Continue
+	}
+	code := int(statusOnError)
+	if code == 0 {
+		code = 403
+	}
+	return &api.LocalResponse{Code: code}
 }
 
 func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) api.ResultAction {
+	if f.config.grpcClient != nil {
+		return f.checkGrpc(headers, data)
+	}
+	return f.checkHTTP(headers, data)
+}
+
+// decision is the cacheable result of the authorization request
+type decision struct {
+	code int
+	// header is added to the request if it's allowed, or to the response if it's denied
+	header http.Header
+}
+
+func (d *decision) apply(headers api.RequestHeaderMap) api.ResultAction {
+	if d.code != 200 {
+		return &api.LocalResponse{Code: d.code, Header: d.header.Clone()}
+	}
+
+	for k, v := range d.header {
+		headers.Set(k, v[len(v)-1])
+	}
+	return api.Continue
+}
+
+func (f *filter) getCacheKey(headers api.RequestHeaderMap, data api.BufferInstance) string {
+	var res any
+	var err error
+	if data != nil {
+		res, err = f.config.cacheKey.EvalWithRequestBody(f.callbacks, headers, data)
+	} else {
+		res, err = f.config.cacheKey.EvalWithRequest(f.callbacks, headers)
+	}
+	if err != nil {
+		api.LogWarnf("failed to evaluate cache key: %v", err)
+		return ""
+	}
+	return res.(string)
+}
+
+// cacheTTL returns the TTL of the decision according to the Cache-Control header.
+// Zero is returned if the decision should not be cached.
+func cacheTTL(c *extauth.Cache, header http.Header) time.Duration {
+	maxAge := -1
+	sMaxAge := -1
+	for _, v := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(v, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			value = strings.Trim(value, `"`)
+			switch strings.ToLower(name) {
+			case "no-store", "no-cache":
+				return 0
+			case "max-age":
+				if n, err := strconv.Atoi(value); err == nil {
+					maxAge = n
+				}
+			case "s-maxage":
+				if n, err := strconv.Atoi(value); err == nil {
+					sMaxAge = n
+				}
+			}
+		}
+	}
+
+	var ttl time.Duration
+	if sMaxAge >= 0 {
+		ttl = time.Duration(sMaxAge) * time.Second
+	} else if maxAge >= 0 {
+		ttl = time.Duration(maxAge) * time.Second
+	} else if c.Ttl != nil {
+		ttl = c.Ttl.AsDuration()
+	}
+	if c.MaxTtl != nil && ttl > c.MaxTtl.AsDuration() {
+		ttl = c.MaxTtl.AsDuration()
+	}
+	return ttl
+}
+
+func (f *filter) checkHTTP(headers api.RequestHeaderMap, data api.BufferInstance) api.ResultAction {
+	var key string
+	if f.config.decisions != nil {
+		key = f.getCacheKey(headers, data)
+		if key != "" {
+			if item := f.config.decisions.Get(key); item != nil {
+				api.LogDebugf("use cached decision for key %s", key)
+				return item.Value().apply(headers)
+			}
+		}
+	}
+
+	hs := f.config.GetHttpService()
+	d, rsp, res := f.callHTTP(headers, data)
+	if d == nil {
+		return res
+	}
+
+	if key != "" {
+		ttl := cacheTTL(hs.GetCache(), rsp.Header)
+		if ttl > 0 {
+			f.config.decisions.Set(key, d, ttl)
+		}
+	}
+	return d.apply(headers)
+}
+
+// callHTTP sends the authorization request. The result action is returned if the decision can't
+// be made.
+func (f *filter) callHTTP(headers api.RequestHeaderMap, data api.BufferInstance) (*decision, *http.Response, api.ResultAction) {
 	hs := f.config.GetHttpService()
-	uri := hs.GetUrl()
-	path, err := url.JoinPath(uri, headers.Path())
+	target, err := url.Parse(hs.GetUrl())
 	if err != nil {
-		api.LogWarnf("failed to join path: %v", err)
-		return &api.LocalResponse{Code: 503}
+		api.LogWarnf("failed to parse url: %v", err)
+		return nil, nil, &api.LocalResponse{Code: 503}
 	}
+	u := headers.URL()
+	target = target.JoinPath(u.Path)
+	target.RawQuery = u.RawQuery
 
-	req, err := http.NewRequest(headers.Method(), path, bytes.NewReader([]byte{}))
+	req, err := http.NewRequest(headers.Method(), target.String(), bytes.NewReader([]byte{}))
 	if err != nil {
 		api.LogWarnf("failed to new request to ext authz server: %v", err)
-		return &api.LocalResponse{Code: 503}
+		return nil, nil, &api.LocalResponse{Code: 503}
 	}
 	req.Host = headers.Host()
 	authz, ok := headers.Get("authorization")
 	if ok {
 		req.Header.Set("authorization", authz)
 	}
-	headersToAdd := hs.GetAuthorizationRequest().GetHeadersToAdd()
-	for _, h := range headersToAdd {
+	if f.config.headerToAuthzMatcher != nil {
+		headers.Range(func(k, v string) bool {
+			if strings.HasPrefix(k, ":") || strings.EqualFold(k, "authorization") {
+				return true
+			}
+			if f.config.headerToAuthzMatcher.Match(k) {
+				req.Header.Add(k, v)
+			}
+			return true
+		})
+	}
+	authzReq := hs.GetAuthorizationRequest()
+	for _, h := range authzReq.GetHeadersToAdd() {
 		// Envoy doesn't support adding multiple same name headers here,
 		// so we don't support it until it's needed
 		req.Header.Set(h.Key, h.Value)
 	}
+	if name := authzReq.GetClientIpHeader(); name != "" {
+		req.Header.Set(name, f.callbacks.StreamInfo().DownstreamRemoteParsedAddress().IP)
+	}
+	if name := authzReq.GetConsumerHeader(); name != "" {
+		if consumer := f.callbacks.GetConsumer(); consumer != nil {
+			req.Header.Set(name, consumer.Name())
+		}
+	}
 
-	if data != nil {
+	if data != nil && hs.GetWithRequestBody() {
 		req.Body = io.NopCloser(bytes.NewReader(data.Bytes()))
 	}
 
@@ -74,17 +236,7 @@ func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) ap
 		} else {
 			api.LogWarnf("failed to call ext authz server: %s", rsp.Status)
 		}
-		if f.config.GetFailureModeAllow() {
-			if f.config.GetFailureModeAllowHeaderAdd() {
-				headers.Set("x-envoy-auth-failure-mode-allowed", "true")
-			}
-			return api.Continue
-		}
-		code := int(hs.GetStatusOnError())
-		if code == 0 {
-			code = 403
-		}
-		return &api.LocalResponse{Code: code}
+		return nil, nil, f.onCheckFailure(headers, int32(hs.GetStatusOnError()))
 	}
 
 	rsp.Body.Close()
@@ -100,21 +252,183 @@ func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) ap
 				}
 			}
 		}
-		return &api.LocalResponse{Code: rsp.StatusCode, Header: rspHdr}
+		return &decision{code: rsp.StatusCode, header: rspHdr}, rsp, nil
 	}
 
+	upHdr := http.Header{}
 	if f.config.headerToUpstreamMatcher != nil {
 		for k, v := range rsp.Header {
 			if f.config.headerToUpstreamMatcher.Match(k) {
-				headers.Set(k, v[len(v)-1])
+				upHdr[k] = v
 			}
 		}
 	}
-	return api.Continue
+	return &decision{code: 200, header: upHdr}, rsp, nil
+}
+
+func socketAddress(addr string) *corev3.Address {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil
+	}
+	p, _ := strconv.ParseUint(port, 10, 32)
+	return &corev3.Address{
+		Address: &corev3.Address_SocketAddress{
+			SocketAddress: &corev3.SocketAddress{
+				Address: host,
+				PortSpecifier: &corev3.SocketAddress_PortValue{
+					PortValue: uint32(p),
+				},
+			},
+		},
+	}
+}
+
+func (f *filter) buildCheckRequest(headers api.RequestHeaderMap, data api.BufferInstance) *authv3.CheckRequest {
+	hdrs := make(map[string]string)
+	headers.Range(func(k, v string) bool {
+		k = strings.ToLower(k)
+		if prev, ok := hdrs[k]; ok {
+			hdrs[k] = prev + "," + v
+		} else {
+			hdrs[k] = v
+		}
+		return true
+	})
+
+	httpReq := &authv3.AttributeContext_HttpRequest{
+		Method:  headers.Method(),
+		Headers: hdrs,
+		Path:    headers.Path(),
+		Host:    headers.Host(),
+		Scheme:  headers.Scheme(),
+	}
+	if u := headers.URL(); u != nil {
+		httpReq.Query = u.RawQuery
+	}
+	info := f.callbacks.StreamInfo()
+	if protocol, ok := info.Protocol(); ok {
+		httpReq.Protocol = protocol
+	}
+	if data != nil {
+		httpReq.Body = data.String()
+		httpReq.Size = int64(data.Len())
+	}
+
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Source: &authv3.AttributeContext_Peer{
+				Address: socketAddress(info.DownstreamRemoteAddress()),
+			},
+			Destination: &authv3.AttributeContext_Peer{
+				Address: socketAddress(info.DownstreamLocalAddress()),
+			},
+			Request: &authv3.AttributeContext_Request{
+				Time: timestamppb.Now(),
+				Http: httpReq,
+			},
+		},
+	}
+}
+
+// applyHeader follows the behavior of Envoy: the `append` field in HeaderValueOption defaults to false
+func applyHeader(headers api.HeaderMap, opt *corev3.HeaderValueOption) {
+	h := opt.GetHeader()
+	if h == nil {
+		return
+	}
+	value := h.Value
+	if value == "" && len(h.RawValue) > 0 {
+		value = string(h.RawValue)
+	}
+
+	if opt.Append != nil {
+		if opt.Append.Value {
+			headers.Add(h.Key, value)
+		} else {
+			headers.Set(h.Key, value)
+		}
+		return
+	}
+
+	switch opt.AppendAction {
+	case corev3.HeaderValueOption_ADD_IF_ABSENT:
+		if _, ok := headers.Get(h.Key); !ok {
+			headers.Add(h.Key, value)
+		}
+	case corev3.HeaderValueOption_OVERWRITE_IF_EXISTS:
+		if _, ok := headers.Get(h.Key); ok {
+			headers.Set(h.Key, value)
+		}
+	default:
+		headers.Set(h.Key, value)
+	}
+}
+
+func (f *filter) checkGrpc(headers api.RequestHeaderMap, data api.BufferInstance) api.ResultAction {
+	gs := f.config.GetGrpcService()
+	ctx, cancel := context.WithTimeout(context.Background(), f.config.grpcTimeout)
+	defer cancel()
+	if len(gs.InitialMetadata) > 0 {
+		kv := make([]string, 0, 2*len(gs.InitialMetadata))
+		for _, h := range gs.InitialMetadata {
+			kv = append(kv, h.Key, h.Value)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+	}
+
+	rsp, err := f.config.grpcClient.Check(ctx, f.buildCheckRequest(headers, data))
+	if err != nil {
+		api.LogWarnf("failed to call ext authz server: %v", err)
+		return f.onCheckFailure(headers, int32(gs.GetStatusOnError()))
+	}
+
+	if rsp.GetStatus().GetCode() == int32(codes.OK) {
+		ok := rsp.GetOkResponse()
+		for _, opt := range ok.GetHeaders() {
+			applyHeader(headers, opt)
+		}
+		for _, k := range ok.GetHeadersToRemove() {
+			k = strings.ToLower(k)
+			// like Envoy, pseudo headers and host can't be removed
+			if strings.HasPrefix(k, ":") || k == "host" {
+				continue
+			}
+			headers.Del(k)
+		}
+		f.responseHeadersToAdd = ok.GetResponseHeadersToAdd()
+		return api.Continue
+	}
+
+	denied := rsp.GetDeniedResponse()
+	code := int(denied.GetStatus().GetCode())
+	if code == 0 {
+		code = 403
+	}
+	var hdr http.Header
+	if len(denied.GetHeaders()) > 0 {
+		hdr = http.Header{}
+		for _, opt := range denied.GetHeaders() {
+			h := opt.GetHeader()
+			if h == nil {
+				continue
+			}
+			if opt.GetAppend().GetValue() {
+				hdr.Add(h.Key, h.Value)
+			} else {
+				hdr.Set(h.Key, h.Value)
+			}
+		}
+	}
+	return &api.LocalResponse{Code: code, Msg: denied.GetBody(), Header: hdr}
 }
 
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	if f.config.GetHttpService().GetWithRequestBody() {
+	withBody := f.config.GetHttpService().GetWithRequestBody()
+	if gs := f.config.GetGrpcService(); gs != nil {
+		withBody = gs.GetWithRequestBody()
+	}
+	if withBody || (f.config.cacheKey != nil && f.config.cacheKey.NeedRequestBody()) {
 		return api.WaitAllData
 	}
 	return f.check(headers, nil)
@@ -123,3 +437,14 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
 	return f.check(headers, data)
 }
+
+type grpcFilter struct {
+	*filter
+}
+
+func (f *grpcFilter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	for _, opt := range f.responseHeadersToAdd {
+		applyHeader(headers, opt)
+	}
+	return api.Continue
+}
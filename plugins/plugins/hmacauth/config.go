@@ -15,6 +15,9 @@
 package hmacauth
 
 import (
+	"runtime"
+	"time"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/types/plugins/hmacauth"
@@ -31,3 +34,48 @@ type plugin struct {
 func (p *plugin) Factory() api.FilterFactory {
 	return factory
 }
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type config struct {
+	hmacauth.CustomConfig
+
+	clockSkew    time.Duration
+	nonceHeader  string
+	nonces       nonceStore
+	digestHeader string
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if conf.ClockSkew != nil {
+		conf.clockSkew = conf.ClockSkew.AsDuration()
+	}
+	if bd := conf.GetBodyDigest(); bd != nil {
+		conf.digestHeader = ContentDigestHeader
+		if bd.Header != "" {
+			conf.digestHeader = bd.Header
+		}
+	}
+
+	nonce := conf.GetNonce()
+	if nonce == nil {
+		return nil
+	}
+
+	conf.nonceHeader = NonceHeader
+	if nonce.Header != "" {
+		conf.nonceHeader = nonce.Header
+	}
+	if nonce.Redis != nil {
+		conf.nonces = newRedisNonceStore(nonce.Redis)
+	} else {
+		conf.nonces = newMemoryNonceStore()
+	}
+	runtime.SetFinalizer(conf, func(conf *config) {
+		api.LogInfof("close nonce store in hmacAuth conf: %+v", conf)
+		conf.nonces.Close()
+	})
+	return nil
+}
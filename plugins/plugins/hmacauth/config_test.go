@@ -51,3 +51,46 @@ func TestConsumerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "nonce",
+			input: `{"clockSkew":"300s", "nonce":{"redis":{"address":"127.0.0.1:6379"}}}`,
+		},
+		{
+			name:  "nonce requires clock skew",
+			input: `{"nonce":{}}`,
+			err:   "clock_skew is required when nonce is configured",
+		},
+		{
+			name:  "invalid clock skew",
+			input: `{"clockSkew":"0s"}`,
+			err:   "invalid Config.ClockSkew: value must be greater than 0s",
+		},
+		{
+			name:  "redis source is required",
+			input: `{"clockSkew":"300s", "nonce":{"redis":{}}}`,
+			err:   "invalid Redis.Source: value is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -15,14 +15,18 @@
 package hmacauth
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/base64"
 	"hash"
+	"net/http"
+	"net/url"
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/types/plugins/hmacauth"
@@ -31,7 +35,7 @@ import (
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &filter{
 		callbacks: callbacks,
-		config:    c.(*hmacauth.Config),
+		config:    c.(*config),
 	}
 }
 
@@ -39,7 +43,7 @@ type filter struct {
 	api.PassThroughFilter
 
 	callbacks api.FilterCallbackHandler
-	config    *hmacauth.Config
+	config    *config
 	consumer  *hmacauth.ConsumerConfig
 }
 
@@ -49,15 +53,25 @@ const (
 	DateHeader      = "date"
 	SignatureHeader = "x-hmac-signature"
 	AccessKeyHeader = "x-hmac-access-key"
+	NonceHeader     = "x-hmac-nonce"
+
+	ContentDigestHeader = "content-digest"
 	// TODO: support algorithm / signed header filters
 )
 
-func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) string {
-	dh := DateHeader
+func (f *filter) dateHeader() string {
 	if f.config.DateHeader != "" {
-		dh = f.config.DateHeader
+		return f.config.DateHeader
 	}
-	date, _ := header.Get(dh)
+	return DateHeader
+}
+
+func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) string {
+	if f.config.Canonicalization == hmacauth.Canonicalization_CANONICAL {
+		return f.getCanonicalSignContent(header, accessKey)
+	}
+
+	date, _ := header.Get(f.dateHeader())
 	url := header.URL()
 	path := url.Path
 	if path == "" {
@@ -99,6 +113,16 @@ func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) s
 	buf.WriteByte('\n')
 	buf.WriteString(date)
 	buf.WriteByte('\n')
+	if f.config.nonceHeader != "" {
+		nonce, _ := header.Get(f.config.nonceHeader)
+		buf.WriteString(nonce)
+		buf.WriteByte('\n')
+	}
+	if f.config.digestHeader != "" {
+		digest, _ := header.Get(f.config.digestHeader)
+		buf.WriteString(digest)
+		buf.WriteByte('\n')
+	}
 	for _, h := range f.consumer.SignedHeaders {
 		hs := header.Values(h)
 		slices.Sort(hs)
@@ -113,6 +137,120 @@ func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) s
 	return buf.String()
 }
 
+// uriEncode encodes the string according to RFC 3986
+func uriEncode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// getCanonicalSignContent builds the content to sign like the common cloud API signing schemes:
+//
+//	<method>\n
+//	<path>\n
+//	<sorted and encoded query parameters>\n
+//	<lowercased and sorted signed headers, each one is name:value\n>\n
+//	<names of the signed headers joined with ;>\n
+//	<access key>
+//
+// The date header, the nonce header and the digest header are always signed.
+func (f *filter) getCanonicalSignContent(header api.RequestHeaderMap, accessKey string) string {
+	u := header.URL()
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	query := u.Query()
+	params := make([][2]string, 0, len(query))
+	for k, v := range query {
+		for _, vv := range v {
+			params = append(params, [2]string{uriEncode(k), uriEncode(vv)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] == params[j][0] {
+			return params[i][1] < params[j][1]
+		}
+		return params[i][0] < params[j][0]
+	})
+
+	names := []string{strings.ToLower(f.dateHeader())}
+	if f.config.nonceHeader != "" {
+		names = append(names, strings.ToLower(f.config.nonceHeader))
+	}
+	if f.config.digestHeader != "" {
+		names = append(names, strings.ToLower(f.config.digestHeader))
+	}
+	for _, h := range f.consumer.SignedHeaders {
+		names = append(names, strings.ToLower(h))
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	buf := strings.Builder{}
+	buf.WriteString(header.Method())
+	buf.WriteByte('\n')
+	buf.WriteString(path)
+	buf.WriteByte('\n')
+	for i, kv := range params {
+		if i > 0 {
+			buf.WriteByte('&')
+		}
+		buf.WriteString(kv[0])
+		buf.WriteByte('=')
+		buf.WriteString(kv[1])
+	}
+	buf.WriteByte('\n')
+	for _, name := range names {
+		hs := header.Values(name)
+		values := make([]string, len(hs))
+		for i, v := range hs {
+			// collapse the sequential spaces
+			values[i] = strings.Join(strings.Fields(v), " ")
+		}
+		buf.WriteString(name)
+		buf.WriteByte(':')
+		buf.WriteString(strings.Join(values, ","))
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	buf.WriteString(strings.Join(names, ";"))
+	buf.WriteByte('\n')
+	buf.WriteString(accessKey)
+	return buf.String()
+}
+
+// verifyDigest checks the body against the digest header. The header can be in the format of
+// `Content-Digest: sha-256=:base64:` or `Digest: SHA-256=base64`. All the supported digests
+// should match and at least one of them should be given.
+func verifyDigest(value string, body []byte) bool {
+	matched := false
+	for _, item := range strings.Split(value, ",") {
+		alg, digest, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+
+		var sum []byte
+		switch strings.ToLower(alg) {
+		case "sha-256":
+			s := sha256.Sum256(body)
+			sum = s[:]
+		case "sha-512":
+			s := sha512.Sum512(body)
+			sum = s[:]
+		default:
+			continue
+		}
+
+		expected, err := base64.StdEncoding.DecodeString(strings.Trim(digest, ":"))
+		if err != nil || !hmac.Equal(sum, expected) {
+			return false
+		}
+		matched = true
+	}
+	return matched
+}
+
 func (f *filter) sign(value []byte) string {
 	secret := []byte(f.consumer.SecretKey)
 
@@ -129,12 +267,70 @@ func (f *filter) sign(value []byte) string {
 	return base64.StdEncoding.EncodeToString(hash.Sum(nil))
 }
 
-func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+var dateLayouts = []string{
+	http.TimeFormat,
+	time.RFC850,
+	time.ANSIC,
+	time.UnixDate,
+	time.RFC3339,
+}
+
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (f *filter) checkReplay(headers api.RequestHeaderMap, accessKey string) api.ResultAction {
 	config := f.config
-	akh := AccessKeyHeader
-	if config.AccessKeyHeader != "" {
-		akh = f.config.AccessKeyHeader
+	if config.clockSkew > 0 {
+		date, _ := headers.Get(f.dateHeader())
+		t, ok := parseDate(date)
+		if !ok {
+			api.LogInfof("invalid date %q", date)
+			return &api.LocalResponse{Code: 401, Msg: "invalid date"}
+		}
+		diff := time.Since(t)
+		if diff > config.clockSkew || diff < -config.clockSkew {
+			api.LogInfof("date %q is out of the clock skew %s", date, config.clockSkew)
+			return &api.LocalResponse{Code: 401, Msg: "invalid date"}
+		}
+	}
+
+	if config.nonces != nil {
+		nonce, _ := headers.Get(config.nonceHeader)
+		if nonce == "" {
+			return &api.LocalResponse{Code: 401, Msg: "missing nonce"}
+		}
+		// A request can be accepted until the date is out of the clock skew. As the date can be
+		// ahead of the gateway's clock, the nonce is kept for twice of the clock skew.
+		added, err := config.nonces.Add(context.Background(), accessKey+"|"+nonce, 2*config.clockSkew)
+		if err != nil {
+			api.LogErrorf("failed to record nonce: %v", err)
+			return &api.LocalResponse{Code: 503}
+		}
+		if !added {
+			api.LogInfof("nonce %s of access key %s is replayed", nonce, accessKey)
+			return &api.LocalResponse{Code: 401, Msg: "replayed request"}
+		}
+	}
+	return api.Continue
+}
+
+func (f *filter) accessKeyHeader() string {
+	if f.config.AccessKeyHeader != "" {
+		return f.config.AccessKeyHeader
 	}
+	return AccessKeyHeader
+}
+
+func (f *filter) verify(headers api.RequestHeaderMap, data api.BufferInstance) api.ResultAction {
+	config := f.config
+	akh := f.accessKeyHeader()
 	sh := SignatureHeader
 	if config.SignatureHeader != "" {
 		sh = f.config.SignatureHeader
@@ -142,11 +338,7 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 
 	// We only cares about one of the headers if multiple is given.
 	// The others will be dropped.
-	accessKey, ok := headers.Get(akh)
-	if !ok {
-		return api.Continue
-	}
-
+	accessKey, _ := headers.Get(akh)
 	name := hmacauth.Name
 	c, ok := f.callbacks.LookupConsumer(name, accessKey)
 	if !ok {
@@ -164,9 +356,44 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 		return &api.LocalResponse{Code: 401, Msg: "invalid signature"}
 	}
 
+	if config.digestHeader != "" {
+		digest, _ := headers.Get(config.digestHeader)
+		var body []byte
+		if data != nil {
+			body = data.Bytes()
+		}
+		if !verifyDigest(digest, body) {
+			api.LogInfof("body digest mismatch: %s", digest)
+			return &api.LocalResponse{Code: 401, Msg: "invalid body digest"}
+		}
+	}
+
+	// Check the replay after verifying the signature, so that the nonces can't be exhausted
+	// by the forged requests
+	if res := f.checkReplay(headers, accessKey); res != api.Continue {
+		return res
+	}
+
 	// drop sensitive headers
 	headers.Del(akh)
 	headers.Del(sh)
+	if config.nonceHeader != "" {
+		headers.Del(config.nonceHeader)
+	}
 	f.callbacks.SetConsumer(c)
 	return api.Continue
 }
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	if _, ok := headers.Get(f.accessKeyHeader()); !ok {
+		return api.Continue
+	}
+	if f.config.digestHeader != "" && !endStream {
+		return api.WaitAllData
+	}
+	return f.verify(headers, nil)
+}
+
+func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	return f.verify(headers, data)
+}
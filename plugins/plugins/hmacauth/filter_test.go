@@ -15,8 +15,14 @@
 package hmacauth
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
@@ -92,7 +98,7 @@ func TestHmacAuth(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cb := envoy.NewFilterCallbackHandler()
-			conf := &hmacauth.Config{}
+			conf := &config{}
 			if tt.conf != "" {
 				protojson.Unmarshal([]byte(tt.conf), conf)
 			}
@@ -127,3 +133,315 @@ func TestHmacAuth(t *testing.T) {
 		})
 	}
 }
+
+type errNonceStore struct{}
+
+func (s *errNonceStore) Add(_ context.Context, _ string, _ time.Duration) (bool, error) {
+	return false, errors.New("ouch")
+}
+
+func (s *errNonceStore) Close() {}
+
+func TestHmacAuthReplay(t *testing.T) {
+	name := hmacauth.Name
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		name: &hmacauth.ConsumerConfig{
+			AccessKey: "ak",
+			SecretKey: "sk",
+		},
+	})
+	now := time.Now().UTC()
+
+	type request struct {
+		date      string
+		nonce     string
+		signNonce string
+		status    int
+	}
+	tests := []struct {
+		name     string
+		conf     string
+		store    nonceStore
+		requests []request
+	}{
+		{
+			name: "clock skew",
+			conf: `{"clockSkew":"300s"}`,
+			requests: []request{
+				{date: now.Format(http.TimeFormat)},
+				{date: now.Add(4 * time.Minute).Format(time.RFC3339)},
+				{date: now.Add(-6 * time.Minute).Format(http.TimeFormat), status: 401},
+				{date: now.Add(6 * time.Minute).Format(time.UnixDate), status: 401},
+				{date: "yesterday", status: 401},
+				{status: 401},
+			},
+		},
+		{
+			name: "nonce",
+			conf: `{"clockSkew":"300s","nonce":{}}`,
+			requests: []request{
+				{date: now.Format(http.TimeFormat), nonce: "1"},
+				{date: now.Format(http.TimeFormat), nonce: "1", status: 401},
+				{date: now.Format(http.TimeFormat), nonce: "2"},
+				{date: now.Format(http.TimeFormat), status: 401},
+				// the nonce is signed
+				{date: now.Format(http.TimeFormat), nonce: "3", signNonce: "4", status: 401},
+			},
+		},
+		{
+			name:  "failed to record nonce",
+			conf:  `{"clockSkew":"300s","nonce":{"header":"x-nonce"}}`,
+			store: &errNonceStore{},
+			requests: []request{
+				{date: now.Format(http.TimeFormat), nonce: "1", status: 503},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			require.NoError(t, protojson.Unmarshal([]byte(tt.conf), conf))
+			require.NoError(t, conf.Validate())
+			require.NoError(t, conf.Init(nil))
+			if tt.store != nil {
+				conf.nonces = tt.store
+			}
+
+			for i, req := range tt.requests {
+				cb := envoy.NewFilterCallbackHandler()
+				patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+				f := factory(conf, cb).(*filter)
+				f.consumer = c.PluginConfig(name).(*hmacauth.ConsumerConfig)
+
+				httpHdr := http.Header{
+					":authority": {"test.local"},
+					":method":    {"GET"},
+					":path":      {"/echo"},
+				}
+				httpHdr.Set(AccessKeyHeader, "ak")
+				if req.date != "" {
+					httpHdr.Set(DateHeader, req.date)
+				}
+				signNonce := req.signNonce
+				if signNonce == "" {
+					signNonce = req.nonce
+				}
+				if signNonce != "" {
+					httpHdr.Set(conf.nonceHeader, signNonce)
+				}
+				signature := f.sign([]byte(f.getSignContent(envoy.NewRequestHeaderMap(httpHdr), "ak")))
+				httpHdr.Set(SignatureHeader, signature)
+				httpHdr.Del(conf.nonceHeader)
+				if req.nonce != "" {
+					httpHdr.Set(conf.nonceHeader, req.nonce)
+				}
+
+				res := f.DecodeHeaders(envoy.NewRequestHeaderMap(httpHdr), true)
+				if req.status != 0 {
+					r, ok := res.(*api.LocalResponse)
+					require.True(t, ok, "request %d: %+v", i, res)
+					assert.Equal(t, req.status, r.Code, "request %d", i)
+				} else {
+					assert.Equal(t, api.Continue, res, "request %d", i)
+				}
+				patches.Reset()
+			}
+		})
+	}
+}
+
+func TestVerifyDigest(t *testing.T) {
+	body := []byte(`{"hello": "world"}`)
+	sha256Sum := sha256.Sum256(body)
+	sha256Digest := base64.StdEncoding.EncodeToString(sha256Sum[:])
+	sha512Sum := sha512.Sum512(body)
+	sha512Digest := base64.StdEncoding.EncodeToString(sha512Sum[:])
+
+	tests := []struct {
+		name   string
+		digest string
+		ok     bool
+	}{
+		{
+			name:   "content-digest",
+			digest: "sha-256=:" + sha256Digest + ":",
+			ok:     true,
+		},
+		{
+			name:   "digest",
+			digest: "SHA-256=" + sha256Digest,
+			ok:     true,
+		},
+		{
+			name:   "multiple digests",
+			digest: "sha-512=:" + sha512Digest + ":, md5=:xxx:, sha-256=:" + sha256Digest + ":",
+			ok:     true,
+		},
+		{
+			name:   "one of the digests mismatched",
+			digest: "sha-512=:" + sha512Digest + ":, sha-256=:" + sha512Digest + ":",
+		},
+		{
+			name:   "unsupported algorithm",
+			digest: "md5=:xxx:",
+		},
+		{
+			name:   "bad base64",
+			digest: "sha-256=:xxx:",
+		},
+		{
+			name: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.ok, verifyDigest(tt.digest, body))
+		})
+	}
+}
+
+func TestCanonicalSignContent(t *testing.T) {
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{
+		"canonicalization": "CANONICAL",
+		"clockSkew": "300s",
+		"nonce": {},
+		"bodyDigest": {}
+	}`), conf))
+	require.NoError(t, conf.Init(nil))
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	f.consumer = &hmacauth.ConsumerConfig{
+		SignedHeaders: []string{"X-Custom-B", "x-custom-a", "date"},
+	}
+
+	hdr := envoy.NewRequestHeaderMap(http.Header{
+		":method":        {"POST"},
+		":path":          {"/echo/a%20b?title=ops&age=36&title=dev&a0=1&a=x%20y&empty"},
+		"Date":           {"Fri, 05 Jan 2024 08:10:54 GMT"},
+		"X-Hmac-Nonce":   {"nonce"},
+		"Content-Digest": {"sha-256=:xxx:"},
+		"X-Custom-A":     {"a", "  b   c "},
+		"X-Custom-C":     {"not signed"},
+	})
+	assert.Equal(t, "POST\n"+
+		"/echo/a%20b\n"+
+		"a=x%20y&a0=1&age=36&empty=&title=dev&title=ops\n"+
+		"content-digest:sha-256=:xxx:\n"+
+		"date:Fri, 05 Jan 2024 08:10:54 GMT\n"+
+		"x-custom-a:a,b c\n"+
+		"x-custom-b:\n"+
+		"x-hmac-nonce:nonce\n"+
+		"\n"+
+		"content-digest;date;x-custom-a;x-custom-b;x-hmac-nonce\n"+
+		"ak", f.getSignContent(hdr, "ak"))
+}
+
+func TestHmacAuthBodyDigest(t *testing.T) {
+	name := hmacauth.Name
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		name: &hmacauth.ConsumerConfig{
+			AccessKey: "ak",
+			SecretKey: "sk",
+		},
+	})
+	body := []byte("body")
+	sum := sha256.Sum256(body)
+	digest := "sha-256=:" + base64.StdEncoding.EncodeToString(sum[:]) + ":"
+	emptySum := sha256.Sum256(nil)
+	emptyDigest := "sha-256=:" + base64.StdEncoding.EncodeToString(emptySum[:]) + ":"
+
+	tests := []struct {
+		name       string
+		conf       string
+		digest     string
+		signDigest string
+		body       []byte
+		status     int
+	}{
+		{
+			name:   "default",
+			conf:   `{"bodyDigest":{}}`,
+			digest: digest,
+			body:   body,
+		},
+		{
+			name:   "canonical",
+			conf:   `{"bodyDigest":{"header":"digest"},"canonicalization":"CANONICAL"}`,
+			digest: digest,
+			body:   body,
+		},
+		{
+			name:   "no body",
+			conf:   `{"bodyDigest":{}}`,
+			digest: emptyDigest,
+		},
+		{
+			name:   "body tampered",
+			conf:   `{"bodyDigest":{}}`,
+			digest: digest,
+			body:   []byte("bodx"),
+			status: 401,
+		},
+		{
+			name:       "digest is signed",
+			conf:       `{"bodyDigest":{}}`,
+			digest:     emptyDigest,
+			signDigest: digest,
+			status:     401,
+		},
+		{
+			name:   "missing digest",
+			conf:   `{"bodyDigest":{}}`,
+			body:   body,
+			status: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			require.NoError(t, protojson.Unmarshal([]byte(tt.conf), conf))
+			require.NoError(t, conf.Init(nil))
+			cb := envoy.NewFilterCallbackHandler()
+			patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+			defer patches.Reset()
+			f := factory(conf, cb).(*filter)
+			f.consumer = c.PluginConfig(name).(*hmacauth.ConsumerConfig)
+
+			httpHdr := http.Header{
+				":authority": {"test.local"},
+				":method":    {"POST"},
+				":path":      {"/echo"},
+			}
+			httpHdr.Set(AccessKeyHeader, "ak")
+			signDigest := tt.signDigest
+			if signDigest == "" {
+				signDigest = tt.digest
+			}
+			httpHdr.Set(conf.digestHeader, signDigest)
+			httpHdr.Set(SignatureHeader, f.sign([]byte(f.getSignContent(envoy.NewRequestHeaderMap(httpHdr), "ak"))))
+			httpHdr.Del(conf.digestHeader)
+			if tt.digest != "" {
+				httpHdr.Set(conf.digestHeader, tt.digest)
+			}
+
+			hdr := envoy.NewRequestHeaderMap(httpHdr)
+			var res api.ResultAction
+			if tt.body == nil {
+				res = f.DecodeHeaders(hdr, true)
+			} else {
+				assert.Equal(t, api.WaitAllData, f.DecodeHeaders(hdr, false))
+				res = f.DecodeRequest(hdr, envoy.NewBufferInstance(tt.body), nil)
+			}
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+			} else {
+				assert.Equal(t, api.Continue, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauth
+
+import (
+	"context"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/redis/go-redis/v9"
+
+	"mosn.io/htnn/plugins/pkg/redisx"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// nonceStore records the nonces which have been used
+type nonceStore interface {
+	// Add returns false if the nonce has been added and not expired
+	Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+	Close()
+}
+
+type memoryNonceStore struct {
+	nonces *ttlcache.Cache[string, struct{}]
+}
+
+func newMemoryNonceStore() *memoryNonceStore {
+	nonces := ttlcache.New(
+		ttlcache.WithDisableTouchOnHit[string, struct{}](),
+	)
+	go nonces.Start()
+	return &memoryNonceStore{nonces: nonces}
+}
+
+func (s *memoryNonceStore) Add(_ context.Context, nonce string, ttl time.Duration) (bool, error) {
+	_, found := s.nonces.GetOrSet(nonce, struct{}{}, ttlcache.WithTTL[string, struct{}](ttl))
+	return !found, nil
+}
+
+func (s *memoryNonceStore) Close() {
+	s.nonces.Stop()
+}
+
+type redisNonceStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func newRedisNonceStore(conf *v1.Redis) *redisNonceStore {
+	client := redisx.NewClientFromConfig(conf)
+
+	prefix := conf.Prefix
+	if prefix == "" {
+		prefix = "htnn_hmac_nonce"
+	}
+	return &redisNonceStore{client: client, prefix: prefix}
+}
+
+func (s *redisNonceStore) Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, s.prefix+"|"+nonce, 1, ttl).Result()
+}
+
+func (s *redisNonceStore) Close() {
+	s.client.Close()
+}
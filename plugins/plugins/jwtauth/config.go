@@ -0,0 +1,133 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/go-jose/go-jose/v4"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+func init() {
+	plugins.RegisterPlugin(jwtauth.Name, &plugin{})
+}
+
+type plugin struct {
+	jwtauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type config struct {
+	jwtauth.CustomConfig
+
+	sources   []*jwtauth.TokenSource
+	leeway    time.Duration
+	localKeys *jose.JSONWebKeySet
+	jwks      *jwksProvider
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	conf.sources = conf.From
+	if len(conf.sources) == 0 {
+		conf.sources = []*jwtauth.TokenSource{
+			{
+				Name:   "Authorization",
+				Prefix: "Bearer ",
+			},
+		}
+	}
+
+	conf.leeway = 60 * time.Second
+	if conf.ClockSkew != nil {
+		conf.leeway = conf.ClockSkew.AsDuration()
+	}
+
+	if jwks := conf.GetLocalJwks(); jwks != "" {
+		var keys jose.JSONWebKeySet
+		if err := json.Unmarshal([]byte(jwks), &keys); err != nil {
+			return fmt.Errorf("invalid local_jwks: %w", err)
+		}
+		conf.localKeys = &keys
+		return nil
+	}
+
+	remote := conf.GetRemoteJwks()
+	timeout := 3 * time.Second
+	if remote.Timeout != nil {
+		timeout = remote.Timeout.AsDuration()
+	}
+	interval := 10 * time.Minute
+	if remote.RefreshInterval != nil {
+		interval = remote.RefreshInterval.AsDuration()
+	}
+
+	provider := newJwksProvider(remote.Url, timeout)
+	err := retry.Do(
+		provider.fetch,
+		retry.RetryIf(func(err error) bool {
+			api.LogWarnf("failed to fetch JWKS from %s, err: %v", remote.Url, err)
+			return true
+		}),
+		retry.Attempts(3),
+		// backoff delay
+		retry.Delay(500*time.Millisecond),
+	)
+	if err != nil {
+		return err
+	}
+
+	conf.jwks = provider
+	// The provider doesn't reference the config, so the config can be garbage collected
+	// and stop the refresh
+	go provider.Start(interval)
+	runtime.SetFinalizer(conf, func(conf *config) {
+		api.LogInfof("stop JWKS refresh in jwtAuth conf: %+v", conf)
+		conf.jwks.Stop()
+	})
+	return nil
+}
+
+// lookupKeys returns the keys which may sign the token
+func (conf *config) lookupKeys(kid string) []jose.JSONWebKey {
+	keys := conf.localKeys
+	if conf.jwks != nil {
+		keys = conf.jwks.Keys()
+	}
+	if kid == "" {
+		return keys.Keys
+	}
+
+	found := keys.Key(kid)
+	if len(found) == 0 && conf.jwks != nil && conf.jwks.Refresh() {
+		// the key may be rotated
+		found = conf.jwks.Keys().Key(kid)
+	}
+	return found
+}
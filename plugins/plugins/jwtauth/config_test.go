@@ -0,0 +1,99 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "jwks is required",
+			input: `{}`,
+			err:   "invalid Config.Jwks: value is required",
+		},
+		{
+			name:  "invalid local jwks",
+			input: `{"localJwks":"{", "issuer":"https://issuer.local", "audiences":["api"]}`,
+			err:   "invalid local_jwks",
+		},
+		{
+			name:  "local jwks without key",
+			input: `{"localJwks":"{\"keys\":[]}", "issuer":"https://issuer.local", "audiences":["api"]}`,
+			err:   "invalid local_jwks: no key",
+		},
+		{
+			name:  "invalid remote jwks url",
+			input: `{"remoteJwks":{"url":"/jwks"}}`,
+			err:   "invalid RemoteJwks.Url: value must be absolute",
+		},
+		{
+			name:  "refresh interval too small",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks", "refreshInterval":"0.1s"}}`,
+			err:   "invalid RemoteJwks.RefreshInterval",
+		},
+		{
+			name:  "invalid header",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "claimsToHeaders":[{"claim":"sub", "header":"x user"}]}`,
+			err:   "invalid ClaimToHeader.Header",
+		},
+		{
+			name:  "invalid token source",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "from":[{"name":""}]}`,
+			err:   "invalid TokenSource.Name",
+		},
+		{
+			name:  "issuer is required",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "audiences":["api"]}`,
+			err:   "issuer is required",
+		},
+		{
+			name:  "audiences is required",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "issuer":"https://issuer.local"}`,
+			err:   "audiences is required",
+		},
+		{
+			name:  "allow any issuer and audience",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "allowAnyIssuer":true, "allowAnyAudience":true}`,
+		},
+		{
+			name:  "ok",
+			input: `{"remoteJwks":{"url":"http://127.0.0.1/jwks"}, "issuer":"https://issuer.local", "audiences":["api"], "clockSkew":"0s"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/bearer"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.EdDSA,
+	jose.HS256,
+	jose.HS384,
+	jose.HS512,
+	jose.RS256,
+	jose.RS384,
+	jose.RS512,
+	jose.ES256,
+	jose.ES384,
+	jose.ES512,
+	jose.PS256,
+	jose.PS384,
+	jose.PS512,
+}
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+}
+
+func getTokenValue(headers api.RequestHeaderMap, src *jwtauth.TokenSource) string {
+	switch src.Source {
+	case jwtauth.Source_QUERY:
+		return headers.URL().Query().Get(src.Name)
+	case jwtauth.Source_COOKIE:
+		if cookie := headers.Cookie(src.Name); cookie != nil {
+			return cookie.Value
+		}
+		return ""
+	default:
+		value, _ := headers.Get(src.Name)
+		return value
+	}
+}
+
+func removeToken(headers api.RequestHeaderMap, src *jwtauth.TokenSource) {
+	switch src.Source {
+	case jwtauth.Source_QUERY:
+		u := *headers.URL()
+		query := u.Query()
+		query.Del(src.Name)
+		u.RawQuery = query.Encode()
+		headers.Set(":path", u.String())
+	case jwtauth.Source_COOKIE:
+		cookies := headers.Cookies()
+		kept := make([]string, 0, len(cookies))
+		for _, c := range cookies {
+			if c.Name != src.Name {
+				kept = append(kept, c.String())
+			}
+		}
+		if len(kept) == 0 {
+			headers.Del("cookie")
+		} else {
+			headers.Set("cookie", strings.Join(kept, "; "))
+		}
+	default:
+		headers.Del(src.Name)
+	}
+}
+
+// extractToken returns the token and removes it from the request if it's not forwarded
+func (f *filter) extractToken(headers api.RequestHeaderMap) (string, bool) {
+	for _, src := range f.config.sources {
+		token := getTokenValue(headers, src)
+		if src.Prefix != "" {
+			if len(token) < len(src.Prefix) || !strings.EqualFold(token[:len(src.Prefix)], src.Prefix) {
+				continue
+			}
+			token = strings.TrimSpace(token[len(src.Prefix):])
+		}
+		if token == "" {
+			continue
+		}
+
+		if !f.config.Forward {
+			removeToken(headers, src)
+		}
+		return token, true
+	}
+	return "", false
+}
+
+var errMissingExpiration = errors.New("missing exp claim")
+
+func (f *filter) verify(raw string) (map[string]any, error) {
+	tok, err := jwt.ParseSigned(raw, supportedAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := tok.Headers[0]
+	var claims jwt.Claims
+	var all map[string]any
+	verified := false
+	for _, k := range f.config.lookupKeys(hdr.KeyID) {
+		if k.Use == "enc" || (k.Algorithm != "" && k.Algorithm != hdr.Algorithm) {
+			continue
+		}
+		key := k.Key
+		if !k.IsPublic() {
+			// the private key is given, use its public part to verify the token
+			if pub := k.Public(); pub.Valid() {
+				key = pub.Key
+			}
+		}
+		if tok.Claims(key, &claims, &all) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, jose.ErrCryptoFailure
+	}
+	if claims.Expiry == nil && !f.config.AllowMissingExpiration {
+		return nil, errMissingExpiration
+	}
+
+	expected := jwt.Expected{
+		Issuer:      f.config.Issuer,
+		AnyAudience: f.config.Audiences,
+		Time:        time.Now(),
+	}
+	if err := claims.ValidateWithLeeway(expected, f.config.leeway); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	config := f.config
+	// remove the headers from the client to prevent spoofing
+	for _, ch := range config.ClaimsToHeaders {
+		headers.Del(ch.Header)
+	}
+
+	token, ok := f.extractToken(headers)
+	if !ok {
+		if config.AllowMissing {
+			return api.Continue
+		}
+		return bearer.Unauthorized("missing token", "")
+	}
+
+	claims, err := f.verify(token)
+	if err != nil {
+		api.LogInfof("invalid token: %v", err)
+		return bearer.Unauthorized("invalid token", "invalid_token")
+	}
+
+	if config.ConsumerClaim != "" {
+		value, ok := claims[config.ConsumerClaim].(string)
+		if !ok {
+			api.LogInfof("claim %s is missing or not a string", config.ConsumerClaim)
+			return bearer.Unauthorized("invalid token", "invalid_token")
+		}
+		c, ok := f.callbacks.LookupConsumer(jwtauth.Name, value)
+		if !ok {
+			api.LogInfof("can not find consumer with claim %s=%s", config.ConsumerClaim, value)
+			return bearer.Unauthorized("consumer not found", "invalid_token")
+		}
+		f.callbacks.SetConsumer(c)
+	}
+
+	bearer.SetClaimsToHeaders(headers, claims, config.ClaimsToHeaders)
+	return api.Continue
+}
@@ -0,0 +1,129 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// The minimal interval between an on-demand refresh and the last fetch, to avoid flooding the
+// JWKS server with the tokens signed by unknown keys, even if the JWKS server is failing
+const minRefreshInterval = 10 * time.Second
+
+type jwksProvider struct {
+	url    string
+	client *http.Client
+
+	lock      sync.RWMutex
+	keys      *jose.JSONWebKeySet
+	lastFetch time.Time
+
+	refreshLock sync.Mutex
+	stop        chan struct{}
+}
+
+func newJwksProvider(url string, timeout time.Duration) *jwksProvider {
+	return &jwksProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		stop:   make(chan struct{}),
+	}
+}
+
+func (p *jwksProvider) fetch() error {
+	// record the attempt before fetching, so a failing JWKS server is not retried on each request
+	p.lock.Lock()
+	p.lastFetch = time.Now()
+	p.lock.Unlock()
+
+	resp, err := p.client.Get(p.url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	p.lock.Lock()
+	p.keys = &keys
+	p.lock.Unlock()
+	return nil
+}
+
+func (p *jwksProvider) Keys() *jose.JSONWebKeySet {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.keys
+}
+
+// Refresh fetches the JWKS on demand, for example, when the key ID in the token is unknown.
+// It returns false if the JWKS is not refreshed.
+func (p *jwksProvider) Refresh() bool {
+	p.refreshLock.Lock()
+	defer p.refreshLock.Unlock()
+
+	p.lock.RLock()
+	last := p.lastFetch
+	p.lock.RUnlock()
+	if time.Since(last) < minRefreshInterval {
+		return false
+	}
+
+	if err := p.fetch(); err != nil {
+		api.LogErrorf("failed to refresh JWKS from %s: %v", p.url, err)
+		return false
+	}
+	return true
+}
+
+func (p *jwksProvider) Start(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.refreshLock.Lock()
+			if err := p.fetch(); err != nil {
+				api.LogErrorf("failed to refresh JWKS from %s: %v", p.url, err)
+			}
+			p.refreshLock.Unlock()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+func (p *jwksProvider) Stop() {
+	close(p.stop)
+}
@@ -0,0 +1,138 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+)
+
+func TestRemoteJwks(t *testing.T) {
+	keys := newTestKeys(t)
+	var jwks atomic.Value
+	jwks.Store(jwksOf(t, keys[0]))
+	var fetched atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched.Add(1)
+		w.Write([]byte(jwks.Load().(string)))
+	}))
+	defer srv.Close()
+
+	input, err := json.Marshal(map[string]any{
+		"remoteJwks": map[string]any{
+			"url":             srv.URL,
+			"refreshInterval": "3600s",
+		},
+		"issuer":    "https://issuer.local",
+		"audiences": []string{"api"},
+	})
+	require.NoError(t, err)
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal(input, conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+	assert.Equal(t, int32(1), fetched.Load())
+
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	valid := jwt.Claims{
+		Issuer:   "https://issuer.local",
+		Subject:  "rick",
+		Audience: jwt.Audience{"api"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	_, err = f.verify(sign(t, keys[0], valid))
+	assert.NoError(t, err)
+
+	// the key is rotated, but the JWKS is just refreshed
+	jwks.Store(jwksOf(t, keys[0], keys[2]))
+	_, err = f.verify(sign(t, keys[2], valid))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), fetched.Load())
+
+	conf.jwks.lock.Lock()
+	conf.jwks.lastFetch = time.Now().Add(-minRefreshInterval)
+	conf.jwks.lock.Unlock()
+	_, err = f.verify(sign(t, keys[2], valid))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), fetched.Load())
+}
+
+func TestRemoteJwksPeriodicRefresh(t *testing.T) {
+	keys := newTestKeys(t)
+	var jwks atomic.Value
+	jwks.Store(jwksOf(t, keys[0]))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(jwks.Load().(string)))
+	}))
+	defer srv.Close()
+
+	p := newJwksProvider(srv.URL, time.Second)
+	require.NoError(t, p.fetch())
+	go p.Start(10 * time.Millisecond)
+	defer p.Stop()
+
+	assert.Len(t, p.Keys().Key("ec"), 0)
+	jwks.Store(jwksOf(t, keys[2]))
+	assert.Eventually(t, func() bool {
+		return len(p.Keys().Key("ec")) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRemoteJwksFetchFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bad" {
+			w.Write([]byte("{"))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	p := newJwksProvider(srv.URL, time.Second)
+	assert.ErrorContains(t, p.fetch(), "unexpected status code 503")
+	p = newJwksProvider(srv.URL+"/bad", time.Second)
+	assert.ErrorContains(t, p.fetch(), "invalid JWKS")
+
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"remoteJwks":{"url":"`+srv.URL+`"}, "allowAnyIssuer":true, "allowAnyAudience":true}`), conf))
+	assert.Error(t, conf.Init(nil))
+}
+
+func TestRemoteJwksRefreshFailed(t *testing.T) {
+	var fetched atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	p := newJwksProvider(srv.URL, time.Second)
+	assert.False(t, p.Refresh())
+	assert.Equal(t, int32(1), fetched.Load())
+	// the failed fetch is rate-limited as well
+	assert.False(t, p.Refresh())
+	assert.Equal(t, int32(1), fetched.Load())
+}
@@ -15,11 +15,24 @@
 package keyauth
 
 import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/types/plugins/keyauth"
 )
 
+const (
+	verifiedKeyTTL  = 5 * time.Minute
+	maxVerifiedKeys = 10000
+	// the max number of the concurrent argon2id verifications of each key ID
+	maxConcurrentVerifications = 2
+)
+
 func init() {
 	plugins.RegisterPlugin(keyauth.Name, &plugin{})
 }
@@ -34,6 +47,49 @@ func (p *plugin) Factory() api.FilterFactory {
 
 type config struct {
 	keyauth.Config
+
+	// id & the digest of the secret => the hashed key which is verified
+	verifiedKeys *ttlcache.Cache[string, *keyauth.HashedKey]
+
+	lock sync.Mutex
+	// id => the number of the argon2id verifications in progress
+	verifying map[string]int
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	// Verifying argon2id is expensive by design, so we cache the successful verifications
+	conf.verifiedKeys = ttlcache.New(
+		ttlcache.WithTTL[string, *keyauth.HashedKey](verifiedKeyTTL),
+		ttlcache.WithCapacity[string, *keyauth.HashedKey](maxVerifiedKeys),
+		ttlcache.WithDisableTouchOnHit[string, *keyauth.HashedKey](),
+	)
+	go conf.verifiedKeys.Start()
+	conf.verifying = make(map[string]int)
+	runtime.SetFinalizer(conf, func(conf *config) {
+		conf.verifiedKeys.Stop()
+	})
+	return nil
+}
+
+func (conf *config) acquireVerification(id string) bool {
+	conf.lock.Lock()
+	defer conf.lock.Unlock()
+
+	if conf.verifying[id] >= maxConcurrentVerifications {
+		return false
+	}
+	conf.verifying[id]++
+	return true
+}
+
+func (conf *config) releaseVerification(id string) {
+	conf.lock.Lock()
+	defer conf.lock.Unlock()
+
+	conf.verifying[id]--
+	if conf.verifying[id] == 0 {
+		delete(conf.verifying, id)
+	}
 }
 
 func (p *plugin) Config() api.PluginConfig {
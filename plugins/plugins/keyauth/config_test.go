@@ -19,6 +19,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/types/plugins/keyauth"
 )
 
 func TestConfig(t *testing.T) {
@@ -56,3 +58,69 @@ func TestConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConsumerConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		index string
+		err   string
+	}{
+		{
+			name:  "key",
+			input: `{"key":"rick"}`,
+			index: "rick",
+		},
+		{
+			name:  "hashed key",
+			input: `{"hashedKey":{"id":"rick","salt":"c2FsdA==","hash":"aGFzaA=="}}`,
+			index: "rick",
+		},
+		{
+			name:  "key is required",
+			input: `{}`,
+			err:   "invalid ConsumerConfig.KeySpecifier: value is required",
+		},
+		{
+			name:  "id contains dot",
+			input: `{"hashedKey":{"id":"ri.ck","salt":"c2FsdA==","hash":"aGFzaA=="}}`,
+			err:   "invalid HashedKey.Id",
+		},
+		{
+			name:  "invalid salt",
+			input: `{"hashedKey":{"id":"rick","salt":"salt!","hash":"aGFzaA=="}}`,
+			err:   "invalid HashedKey.Salt",
+		},
+		{
+			name:  "too many threads",
+			input: `{"hashedKey":{"id":"rick","salt":"c2FsdA==","hash":"aGFzaA==","argon2":{"threads":5}}}`,
+			err:   "invalid Argon2Params.Threads",
+		},
+		{
+			name:  "too many passes",
+			input: `{"hashedKey":{"id":"rick","salt":"c2FsdA==","hash":"aGFzaA==","argon2":{"time":4}}}`,
+			err:   "invalid Argon2Params.Time",
+		},
+		{
+			name:  "too much memory",
+			input: `{"hashedKey":{"id":"rick","salt":"c2FsdA==","hash":"aGFzaA==","argon2":{"memory":65537}}}`,
+			err:   "invalid Argon2Params.Memory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &keyauth.ConsumerConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.index, conf.Index())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -15,7 +15,15 @@
 package keyauth
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"net/url"
+	"strings"
+
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/crypto/argon2"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/types/plugins/keyauth"
@@ -35,10 +43,109 @@ type filter struct {
 	config    *config
 }
 
-func (f *filter) verify(value string) api.ResultAction {
+func verifyHashedKey(hk *keyauth.HashedKey, secret string) bool {
+	salt, err := base64.StdEncoding.DecodeString(hk.Salt)
+	if err != nil {
+		api.LogErrorf("bad salt of hashed key %s: %v", hk.Id, err)
+		return false
+	}
+	expected, err := base64.StdEncoding.DecodeString(hk.Hash)
+	if err != nil {
+		api.LogErrorf("bad hash of hashed key %s: %v", hk.Id, err)
+		return false
+	}
+
+	var actual []byte
+	switch hk.Algorithm {
+	case keyauth.HashAlgorithm_ARGON2ID:
+		// the default parameters are the second recommended option in RFC 9106
+		time, memory, threads := uint32(3), uint32(64*1024), uint32(4)
+		if p := hk.Argon2; p != nil {
+			if p.Time > 0 {
+				time = p.Time
+			}
+			if p.Memory > 0 {
+				memory = p.Memory
+			}
+			if p.Threads > 0 {
+				threads = p.Threads
+			}
+		}
+		actual = argon2.IDKey([]byte(secret), salt, time, memory, uint8(threads), uint32(len(expected)))
+	default:
+		h := sha256.New()
+		h.Write(salt)
+		h.Write([]byte(secret))
+		actual = h.Sum(nil)
+	}
+	return subtle.ConstantTimeCompare(actual, expected) == 1
+}
+
+var (
+	errInvalidKey           = errors.New("invalid key")
+	errTooManyVerifications = errors.New("too many verifications of the key")
+)
+
+func (f *filter) lookupConsumer(value string) (api.Consumer, error) {
 	c, ok := f.callbacks.LookupConsumer(keyauth.Name, value)
+	if ok {
+		conf, _ := c.PluginConfig(keyauth.Name).(*keyauth.ConsumerConfig)
+		if conf.GetHashedKey() == nil {
+			return c, nil
+		}
+	}
+
+	id, secret, found := strings.Cut(value, ".")
+	if !found {
+		return nil, errInvalidKey
+	}
+	c, ok = f.callbacks.LookupConsumer(keyauth.Name, id)
 	if !ok {
-		return &api.LocalResponse{Code: 401, Msg: "invalid key"}
+		return nil, errInvalidKey
+	}
+	conf, _ := c.PluginConfig(keyauth.Name).(*keyauth.ConsumerConfig)
+	hk := conf.GetHashedKey()
+	if hk == nil {
+		return nil, errInvalidKey
+	}
+	if err := f.verifyHashedKeyWithCache(hk, secret); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (f *filter) verifyHashedKeyWithCache(hk *keyauth.HashedKey, secret string) error {
+	// Only the digest of the secret is kept in memory
+	digest := sha256.Sum256([]byte(secret))
+	key := hk.Id + "." + base64.StdEncoding.EncodeToString(digest[:])
+	// The cached result is stale if the consumer is updated
+	if item := f.config.verifiedKeys.Get(key); item != nil && item.Value() == hk {
+		return nil
+	}
+
+	if hk.Algorithm == keyauth.HashAlgorithm_ARGON2ID {
+		// As the key ID is public, limit the concurrent verifications so that guessing the secret
+		// of a key can't exhaust the CPU and the memory
+		if !f.config.acquireVerification(hk.Id) {
+			return errTooManyVerifications
+		}
+		defer f.config.releaseVerification(hk.Id)
+	}
+
+	if !verifyHashedKey(hk, secret) {
+		return errInvalidKey
+	}
+	f.config.verifiedKeys.Set(key, hk, ttlcache.DefaultTTL)
+	return nil
+}
+
+func (f *filter) verify(value string) api.ResultAction {
+	c, err := f.lookupConsumer(value)
+	if err != nil {
+		if errors.Is(err, errTooManyVerifications) {
+			return &api.LocalResponse{Code: 429, Msg: err.Error()}
+		}
+		return &api.LocalResponse{Code: 401, Msg: err.Error()}
 	}
 
 	f.callbacks.SetConsumer(c)
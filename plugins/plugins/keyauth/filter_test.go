@@ -0,0 +1,192 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/keyauth"
+)
+
+func sha256Hash(salt, secret string) string {
+	h := sha256.Sum256([]byte(salt + secret))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+func TestKeyAuth(t *testing.T) {
+	salt := base64.StdEncoding.EncodeToString([]byte("salt"))
+	argon2Hash := base64.StdEncoding.EncodeToString(
+		argon2.IDKey([]byte("secret"), []byte("salt"), 1, 64, 1, 16))
+
+	consumers := map[string]*keyauth.ConsumerConfig{}
+	for _, c := range []*keyauth.ConsumerConfig{
+		{KeySpecifier: &keyauth.ConsumerConfig_Key{Key: "plain"}},
+		{KeySpecifier: &keyauth.ConsumerConfig_HashedKey{HashedKey: &keyauth.HashedKey{
+			Id:   "sha",
+			Salt: salt,
+			Hash: sha256Hash("salt", "secret"),
+		}}},
+		{KeySpecifier: &keyauth.ConsumerConfig_HashedKey{HashedKey: &keyauth.HashedKey{
+			Id:        "argon",
+			Algorithm: keyauth.HashAlgorithm_ARGON2ID,
+			Salt:      salt,
+			Hash:      argon2Hash,
+			Argon2: &keyauth.Argon2Params{
+				Time:    1,
+				Memory:  64,
+				Threads: 1,
+			},
+		}}},
+	} {
+		consumers[c.Index()] = c
+	}
+
+	tests := []struct {
+		name   string
+		key    string
+		status int
+	}{
+		{
+			name: "plaintext key",
+			key:  "plain",
+		},
+		{
+			name:   "plaintext key mismatched",
+			key:    "plain.secret",
+			status: 401,
+		},
+		{
+			name: "sha256",
+			key:  "sha.secret",
+		},
+		{
+			name:   "sha256 mismatched",
+			key:    "sha.secrets",
+			status: 401,
+		},
+		{
+			name:   "id only",
+			key:    "sha",
+			status: 401,
+		},
+		{
+			name: "argon2id",
+			key:  "argon.secret",
+		},
+		{
+			name:   "argon2id mismatched",
+			key:    "argon.",
+			status: 401,
+		},
+		{
+			name:   "unknown id",
+			key:    "unknown.secret",
+			status: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			conf := &config{}
+			require.NoError(t, protojson.Unmarshal([]byte(`{"keys":[{"name":"Authorization"}]}`), conf))
+			require.NoError(t, conf.Init(nil))
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+				c, ok := consumers[key]
+				if !ok {
+					return nil, false
+				}
+				return consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+					keyauth.Name: c,
+				}), true
+			})
+			defer patches.Reset()
+
+			hdr := envoy.NewRequestHeaderMap(http.Header{
+				"Authorization": []string{tt.key},
+			})
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+			} else {
+				assert.Equal(t, api.Continue, res)
+				assert.NotNil(t, cb.GetConsumer())
+			}
+		})
+	}
+}
+
+func TestVerifiedKeysCache(t *testing.T) {
+	conf := &config{}
+	require.NoError(t, conf.Init(nil))
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+
+	salt := base64.StdEncoding.EncodeToString([]byte("salt"))
+	hk := &keyauth.HashedKey{
+		Id:        "argon",
+		Algorithm: keyauth.HashAlgorithm_ARGON2ID,
+		Salt:      salt,
+		Hash: base64.StdEncoding.EncodeToString(
+			argon2.IDKey([]byte("secret"), []byte("salt"), 1, 64, 1, 16)),
+		Argon2: &keyauth.Argon2Params{
+			Time:    1,
+			Memory:  64,
+			Threads: 1,
+		},
+	}
+	assert.ErrorIs(t, f.verifyHashedKeyWithCache(hk, "secrets"), errInvalidKey)
+	assert.Equal(t, 0, conf.verifiedKeys.Len())
+	assert.NoError(t, f.verifyHashedKeyWithCache(hk, "secret"))
+	assert.Equal(t, 1, conf.verifiedKeys.Len())
+
+	patches := gomonkey.ApplyFunc(verifyHashedKey, func(_ *keyauth.HashedKey, _ string) bool {
+		return false
+	})
+	defer patches.Reset()
+	// hit the cache
+	assert.NoError(t, f.verifyHashedKeyWithCache(hk, "secret"))
+	assert.ErrorIs(t, f.verifyHashedKeyWithCache(hk, "secrets"), errInvalidKey)
+
+	// the consumer is updated
+	updated := proto.Clone(hk).(*keyauth.HashedKey)
+	assert.ErrorIs(t, f.verifyHashedKeyWithCache(updated, "secret"), errInvalidKey)
+
+	// too many concurrent verifications
+	for i := 0; i < maxConcurrentVerifications; i++ {
+		require.True(t, conf.acquireVerification(hk.Id))
+	}
+	assert.ErrorIs(t, f.verifyHashedKeyWithCache(updated, "secret"), errTooManyVerifications)
+	conf.releaseVerification(hk.Id)
+	assert.ErrorIs(t, f.verifyHashedKeyWithCache(updated, "secret"), errInvalidKey)
+	conf.releaseVerification(hk.Id)
+	assert.Empty(t, conf.verifying)
+}
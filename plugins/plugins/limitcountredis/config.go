@@ -15,7 +15,6 @@
 package limitcountredis
 
 import (
-	"crypto/tls"
 	"fmt"
 	"strings"
 
@@ -24,6 +23,7 @@ import (
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/pkg/redisx"
 	"mosn.io/htnn/types/pkg/expr"
 	"mosn.io/htnn/types/plugins/limitcountredis"
 )
@@ -72,35 +72,19 @@ type Limiter struct {
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
-	addr := conf.GetAddress()
-	if addr != "" {
-		opt := &redis.Options{
-			Addr:     addr,
-			Username: conf.Username,
-			Password: conf.Password,
-		}
-		if conf.Tls {
-			opt.TLSConfig = &tls.Config{
-				InsecureSkipVerify: conf.TlsSkipVerify,
-			}
-		}
-
-		conf.client = redis.NewClient(opt)
-
+	client := redisx.NewClient(&redisx.Options{
+		Address:          conf.GetAddress(),
+		ClusterAddresses: conf.GetCluster().GetAddresses(),
+		Username:         conf.Username,
+		Password:         conf.Password,
+		TLS:              conf.Tls,
+		TLSSkipVerify:    conf.TlsSkipVerify,
+	})
+	// The cluster mode requires a different way to run the script
+	if c, ok := client.(*redis.ClusterClient); ok {
+		conf.clusterClient = c
 	} else {
-		cluster := conf.GetCluster()
-		opt := &redis.ClusterOptions{
-			Addrs:    cluster.Addresses,
-			Username: conf.Username,
-			Password: conf.Password,
-		}
-		if conf.Tls {
-			opt.TLSConfig = &tls.Config{
-				InsecureSkipVerify: conf.TlsSkipVerify,
-			}
-		}
-
-		conf.clusterClient = redis.NewClusterClient(opt)
+		conf.client = client.(*redis.Client)
 	}
 
 	prefix := conf.Prefix
@@ -119,7 +103,10 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		if rule.Key == "" {
 			continue
 		}
-		script, _ := expr.CompileCel(rule.Key, cel.StringType)
+		script, err := expr.CompileCel(rule.Key, cel.StringType)
+		if err != nil {
+			return fmt.Errorf("invalid rules[%d].key: %w", i, err)
+		}
 		conf.limiters[i].script = script
 	}
 	conf.quotaPolicy = strings.Join(quotaPolicy, ", ")
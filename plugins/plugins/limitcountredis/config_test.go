@@ -55,7 +55,7 @@ func TestConfig(t *testing.T) {
 		{
 			name:  "bad expr",
 			input: `{"address":"127.0.0.1:6479", "prefix":"test", "rules":[{"count":1,"timeWindow":"1s","key":"request.headers"}]}`,
-			err:   "unexpected failed resolution",
+			err:   "invalid rules[0].key",
 		},
 		{
 			name:  "passwd",
@@ -80,6 +80,10 @@ func TestConfig(t *testing.T) {
 				err = conf.Init(nil)
 				assert.Nil(t, err)
 			} else {
+				if err == nil {
+					// the CEL expressions are compiled during initialization
+					err = conf.Init(nil)
+				}
 				assert.ErrorContains(t, err, tt.err)
 			}
 		})
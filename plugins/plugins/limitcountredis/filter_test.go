@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 	"mosn.io/htnn/types/pkg/expr"
 )
@@ -36,6 +37,9 @@ func TestGetKey(t *testing.T) {
 	require.NoError(t, err)
 	s2, err := expr.CompileCel(`request.header("food")`, cel.StringType)
 	require.NoError(t, err)
+	s3, err := expr.CompileCel(`consumer.label("tier")`, cel.StringType)
+	require.NoError(t, err)
+	cb.SetConsumer(consumer.NewConsumerWithMetadata(nil, map[string]string{"tier": "gold"}))
 
 	tests := []struct {
 		name   string
@@ -56,6 +60,11 @@ func TestGetKey(t *testing.T) {
 			script: s2,
 			key:    "183.128.130.43",
 		},
+		{
+			name:   "use consumer metadata",
+			script: s3,
+			key:    "gold",
+		},
 	}
 
 	for _, tt := range tests {
@@ -15,12 +15,14 @@
 package limitreq
 
 import (
+	"fmt"
 	"runtime"
 	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/jellydator/ttlcache/v3"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
@@ -44,8 +46,21 @@ func (p *plugin) Config() api.PluginConfig {
 	return &config{}
 }
 
+// Merge merges the consumer's config into the route's config field by field, so that the
+// consumer can override only part of the config, like the `average`. As the fields with zero
+// value are not distinguished from the unset ones in proto3, the consumer can't reset a field to
+// zero, like clearing the `key` to share one bucket among all the requests.
+func (p *plugin) Merge(parent interface{}, child interface{}) interface{} {
+	parentConf := parent.(*config)
+	childConf := child.(*config)
+	conf := &config{}
+	proto.Merge(&conf.Config, &parentConf.Config)
+	proto.Merge(&conf.Config, &childConf.Config)
+	return conf
+}
+
 type config struct {
-	limitreq.CustomConfig
+	limitreq.Config
 
 	buckets *ttlcache.Cache[string, *rate.Limiter]
 	// Like traefik, we also require a max delay to avoid holding the requests for unlimited time.
@@ -56,6 +71,14 @@ type config struct {
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if conf.Key != "" {
+		s, err := expr.CompileCel(conf.Key, cel.StringType)
+		if err != nil {
+			return fmt.Errorf("invalid key: %w", err)
+		}
+		conf.script = s
+	}
+
 	period := time.Second
 	if conf.Period != nil {
 		period = conf.Period.AsDuration()
@@ -94,8 +117,5 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		conf.buckets.Stop()
 	})
 
-	if conf.Key != "" {
-		conf.script, _ = expr.CompileCel(conf.Key, cel.StringType)
-	}
 	return nil
 }
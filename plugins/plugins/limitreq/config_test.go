@@ -75,8 +75,31 @@ func TestConfig(t *testing.T) {
 				assert.Nil(t, err)
 				assert.Equal(t, tt.maxDelay, conf.maxDelay)
 			} else {
+				if err == nil {
+					// the CEL expressions are compiled during initialization
+					err = conf.Init(nil)
+				}
 				assert.ErrorContains(t, err, tt.err)
 			}
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	parent := &config{}
+	err := protojson.Unmarshal([]byte(`{"average":1,"burst":2,"key":"request.header(\"x-key\")"}`), parent)
+	assert.Nil(t, err)
+	child := &config{}
+	err = protojson.Unmarshal([]byte(`{"average":10,"burst":0,"key":""}`), child)
+	assert.Nil(t, err)
+
+	p := &plugin{}
+	conf := p.Merge(parent, child).(*config)
+	assert.Equal(t, uint32(10), conf.Average)
+	// the zero values can't reset the fields
+	assert.Equal(t, uint32(2), conf.Burst)
+	assert.Equal(t, `request.header("x-key")`, conf.Key)
+	// the original configs are not changed
+	assert.Equal(t, uint32(1), parent.Average)
+	assert.Equal(t, "", child.Key)
+}
@@ -0,0 +1,66 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/multiauth"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+		mode  plugins.AuthnCompositionMode
+	}{
+		{
+			name:  "default",
+			input: `{}`,
+			mode:  plugins.AuthnCompositionModeAnyOf,
+		},
+		{
+			name:  "all of",
+			input: `{"mode":"ALL_OF"}`,
+			mode:  plugins.AuthnCompositionModeAllOf,
+		},
+		{
+			name:  "invalid mode",
+			input: `{"mode":"NONE_OF"}`,
+			err:   "invalid value for enum",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &multiauth.Config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.mode, conf.AuthnCompositionMode())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
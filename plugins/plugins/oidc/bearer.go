@@ -0,0 +1,139 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/bearer"
+)
+
+var errInactiveToken = errors.New("inactive token")
+
+func audienceMatched(aud any, expected []string) bool {
+	switch v := aud.(type) {
+	case string:
+		return slices.Contains(expected, v)
+	case []any:
+		for _, a := range v {
+			if s, ok := a.(string); ok && slices.Contains(expected, s) {
+				return true
+			}
+		}
+	case []string:
+		for _, s := range v {
+			if slices.Contains(expected, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// introspect validates the token via OAuth 2.0 Token Introspection (RFC 7662)
+func (f *filter) introspect(ctx context.Context, token string) (map[string]any, error) {
+	config := f.config
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", "access_token")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.introspectionEndpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(config.ClientId), url.QueryEscape(config.ClientSecret))
+
+	client := &http.Client{Timeout: config.opTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var claims map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("bad introspection response: %w", err)
+	}
+	if active, _ := claims["active"].(bool); !active {
+		return nil, errInactiveToken
+	}
+	// the token issued for other APIs is also active
+	if !audienceMatched(claims["aud"], config.audiences) {
+		return nil, errInactiveToken
+	}
+	return claims, nil
+}
+
+func (f *filter) verifyAccessToken(ctx context.Context, token string) (map[string]any, error) {
+	config := f.config
+	accessToken, err := config.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !audienceMatched(accessToken.Audience, config.audiences) {
+		return nil, errors.New("audience mismatched")
+	}
+
+	var claims map[string]any
+	if err := accessToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (f *filter) handleBearer(headers api.RequestHeaderMap) api.ResultAction {
+	config := f.config
+	const prefix = "Bearer "
+	auth, _ := headers.Get("authorization")
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return bearer.Unauthorized("missing token", "")
+	}
+	token := strings.TrimSpace(auth[len(prefix):])
+	if token == "" {
+		return bearer.Unauthorized("missing token", "")
+	}
+
+	ctx := config.ctxWithClient(context.Background())
+	var claims map[string]any
+	var err error
+	if config.introspectionEndpoint != "" {
+		claims, err = f.introspect(ctx, token)
+		if err != nil && !errors.Is(err, errInactiveToken) {
+			api.LogErrorf("failed to introspect token: %v", err)
+			return &api.LocalResponse{Code: 503, Msg: "failed to introspect token"}
+		}
+	} else {
+		claims, err = f.verifyAccessToken(ctx, token)
+	}
+	if err != nil {
+		api.LogInfof("invalid token: %v", err)
+		return bearer.Unauthorized("invalid token", "invalid_token")
+	}
+
+	return f.applyClaims(headers, claims)
+}
@@ -0,0 +1,300 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	oidctype "mosn.io/htnn/types/plugins/oidc"
+)
+
+type testProvider struct {
+	*httptest.Server
+
+	key *rsa.PrivateKey
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &testProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/auth",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+			"introspection_endpoint": p.URL + "/introspect",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "k1", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/introspect", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.PostFormValue("token") {
+		case "active":
+			json.NewEncoder(w).Encode(map[string]any{
+				"active": true, "sub": "rick", "aud": []string{"api"}, "scope": "read",
+			})
+		case "other-aud":
+			json.NewEncoder(w).Encode(map[string]any{"active": true, "sub": "rick", "aud": "web"})
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			json.NewEncoder(w).Encode(map[string]any{"active": false})
+		}
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *testProvider) sign(t *testing.T, claims any) string {
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: p.key, KeyID: "k1"},
+	}, nil)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func newBearerConfig(t *testing.T, p *testProvider, extra string) *config {
+	conf := &config{}
+	input := `{"clientId":"client", "issuer":"` + p.URL + `", "mode":"BEARER"` + extra + `}`
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+	return conf
+}
+
+func TestBearerMode(t *testing.T) {
+	p := newTestProvider(t)
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   p.URL,
+		Subject:  "rick",
+		Audience: jwt.Audience{"api"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	audiences := `, "bearer":{"audiences":["api"]}`
+
+	tests := []struct {
+		name   string
+		extra  string
+		auth   string
+		status int
+		msg    string
+		upHdr  http.Header
+	}{
+		{
+			name:   "missing token",
+			extra:  audiences,
+			status: 401,
+		},
+		{
+			name:   "not bearer",
+			auth:   "Basic amFjazIwMjE6MTIzNDU2",
+			extra:  audiences,
+			status: 401,
+		},
+		{
+			name:  "jwt",
+			auth:  "Bearer " + p.sign(t, valid),
+			extra: audiences + `, "claimsToHeaders":[{"claim":"sub", "header":"x-user"}]`,
+			upHdr: http.Header{"X-User": {"rick"}},
+		},
+		{
+			name: "expired",
+			auth: "Bearer " + p.sign(t, jwt.Claims{
+				Issuer:   p.URL,
+				Audience: valid.Audience,
+				Expiry:   jwt.NewNumericDate(now.Add(-time.Hour)),
+			}),
+			extra:  audiences,
+			status: 401,
+		},
+		{
+			name: "id token",
+			auth: "Bearer " + p.sign(t, jwt.Claims{
+				Issuer:   p.URL,
+				Subject:  "rick",
+				Audience: jwt.Audience{"client"},
+				Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+			}),
+			extra:  audiences,
+			status: 401,
+		},
+		{
+			name: "configured audiences",
+			auth: "Bearer " + p.sign(t, jwt.Claims{
+				Issuer:   p.URL,
+				Audience: jwt.Audience{"web", "api"},
+				Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+			}),
+			extra: audiences,
+		},
+		{
+			name:   "bad signature",
+			auth:   "Bearer " + p.sign(t, valid) + "x",
+			extra:  audiences,
+			status: 401,
+		},
+		{
+			name:  "introspection",
+			auth:  "Bearer active",
+			extra: `, "clientSecret":"secret", "bearer":{"introspection":true, "audiences":["api"]}, "claimsToHeaders":[{"claim":"scope", "header":"x-scope"}]`,
+			upHdr: http.Header{"X-Scope": {"read"}},
+		},
+		{
+			name:   "introspection, inactive",
+			auth:   "Bearer inactive",
+			extra:  `, "clientSecret":"secret", "bearer":{"introspection":true, "audiences":["api"]}`,
+			status: 401,
+		},
+		{
+			name:   "introspection, audience mismatched",
+			auth:   "Bearer other-aud",
+			extra:  `, "clientSecret":"secret", "bearer":{"introspection":true, "audiences":["api"]}`,
+			status: 401,
+		},
+		{
+			name:   "introspection, error",
+			auth:   "Bearer error",
+			extra:  `, "clientSecret":"secret", "bearer":{"introspection":true, "audiences":["api"]}`,
+			status: 503,
+			msg:    "failed to introspect token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := newBearerConfig(t, p, tt.extra)
+			cb := envoy.NewFilterCallbackHandler()
+			f := factory(conf, cb).(*filter)
+			h := http.Header{}
+			if tt.auth != "" {
+				h.Set("Authorization", tt.auth)
+			}
+			h.Set("X-User", "spoofed")
+			hdr := envoy.NewRequestHeaderMap(h)
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok, res)
+				assert.Equal(t, tt.status, r.Code)
+				if tt.msg != "" {
+					assert.Equal(t, tt.msg, r.Msg)
+				}
+				if r.Code == 401 {
+					assert.Contains(t, r.Header.Get("WWW-Authenticate"), "Bearer")
+				}
+				return
+			}
+
+			require.Equal(t, api.Continue, res)
+			for k, v := range tt.upHdr {
+				assert.Equal(t, v, hdr.Values(k), k)
+			}
+		})
+	}
+}
+
+func TestBearerModeConsumer(t *testing.T) {
+	p := newTestProvider(t)
+	conf := newBearerConfig(t, p, `, "consumerClaim":"sub", "bearer":{"audiences":["api"]}`)
+
+	cb := envoy.NewFilterCallbackHandler()
+	patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+		if key != "rick" {
+			return nil, false
+		}
+		return consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+			oidctype.Name: &oidctype.ConsumerConfig{ClaimValue: key},
+		}), true
+	})
+	defer patches.Reset()
+
+	for _, sub := range []string{"rick", "morty"} {
+		f := factory(conf, cb).(*filter)
+		token := p.sign(t, jwt.Claims{
+			Issuer:   p.URL,
+			Subject:  sub,
+			Audience: jwt.Audience{"api"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+		h := http.Header{}
+		h.Set("Authorization", "Bearer "+token)
+		res := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true)
+		if sub == "rick" {
+			assert.Equal(t, api.Continue, res)
+			require.NotNil(t, cb.GetConsumer())
+			assert.Equal(t, "rick", cb.GetConsumer().PluginConfig(oidctype.Name).Index())
+		} else {
+			assert.Equal(t, &api.LocalResponse{Code: 401, Msg: "consumer not found"}, res)
+		}
+	}
+}
+
+func TestPublicClient(t *testing.T) {
+	p := newTestProvider(t)
+	conf := &config{}
+	input := `{"clientId":"client", "issuer":"` + p.URL + `", "redirectUrl":"http://127.0.0.1:10000/callback", "cookieSecret":"0123456789abcdef"}`
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+	assert.Equal(t, oauth2.AuthStyleInParams, conf.oauth2Config.Endpoint.AuthStyle)
+
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb).(*filter)
+	h := http.Header{}
+	h.Set(":path", "/echo")
+	resp := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true).(*api.LocalResponse)
+	require.Equal(t, http.StatusFound, resp.Code)
+	loc := resp.Header.Get("Location")
+	assert.Contains(t, loc, "code_challenge_method=S256")
+	assert.NotContains(t, loc, "client_secret")
+
+	// the state is signed with the cookie secret
+	req, _ := http.NewRequest(http.MethodGet, loc, nil)
+	assert.True(t, verifyState(req.URL.Query().Get("state"), "0123456789abcdef"))
+}
@@ -17,7 +17,9 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"net/http"
+	"runtime"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -47,14 +49,27 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	oidctype.Config
-
-	opTimeout      time.Duration
-	oauth2Config   *oauth2.Config
-	verifier       *oidc.IDTokenVerifier
-	cookieEncoding *securecookie.SecureCookie
-	refreshLeeway  time.Duration
-	cookieEntryID  string
+	oidctype.CustomConfig
+
+	opTimeout          time.Duration
+	oauth2Config       *oauth2.Config
+	verifier           *oidc.IDTokenVerifier
+	cookieEncoding     *securecookie.SecureCookie
+	refreshLeeway      time.Duration
+	cookieEntryID      string
+	endSessionEndpoint string
+	sessions           sessionStore
+
+	introspectionEndpoint string
+	audiences             []string
+}
+
+// secret returns the secret to sign the state and the cookies
+func (conf *config) secret() string {
+	if conf.CookieSecret != "" {
+		return conf.CookieSecret
+	}
+	return conf.ClientSecret
 }
 
 func (conf *config) ctxWithClient(ctx context.Context) context.Context {
@@ -101,6 +116,10 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		return err
 	}
 
+	if conf.Mode == oidctype.Mode_BEARER {
+		return conf.initBearerMode(provider)
+	}
+
 	if !conf.DisableAccessTokenRefresh {
 		conf.Scopes = append(conf.Scopes, oidc.ScopeOfflineAccess)
 	}
@@ -114,8 +133,60 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		// Discovery returns the OAuth2 endpoints.
 		Endpoint: provider.Endpoint(),
 	}
+	if conf.ClientSecret == "" {
+		// The public client only sends the client ID and relies on PKCE
+		conf.oauth2Config.Endpoint.AuthStyle = oauth2.AuthStyleInParams
+	}
 	conf.verifier = provider.Verifier(&oidc.Config{ClientID: conf.ClientId})
-	conf.cookieEncoding = securecookie.New([]byte(conf.ClientSecret), nil)
+	conf.cookieEncoding = securecookie.New([]byte(conf.secret()), nil)
 	conf.cookieEntryID = base64.RawURLEncoding.EncodeToString([]byte(conf.ClientId))
+
+	if conf.Logout != nil {
+		var claims struct {
+			EndSessionEndpoint string `json:"end_session_endpoint"`
+		}
+		if err := provider.Claims(&claims); err != nil {
+			return err
+		}
+		conf.endSessionEndpoint = claims.EndSessionEndpoint
+	}
+
+	session := conf.GetSession()
+	if session == nil {
+		return nil
+	}
+
+	if session.Redis != nil {
+		conf.sessions = newRedisSessionStore(session.Redis)
+	} else {
+		conf.sessions = newMemorySessionStore()
+	}
+	runtime.SetFinalizer(conf, func(conf *config) {
+		api.LogInfof("close session store in oidc conf, client id: %s", conf.ClientId)
+		conf.sessions.Close()
+	})
+	return nil
+}
+
+func (conf *config) initBearerMode(provider *oidc.Provider) error {
+	bearer := conf.GetBearer()
+	if bearer.GetIntrospection() {
+		var claims struct {
+			IntrospectionEndpoint string `json:"introspection_endpoint"`
+		}
+		if err := provider.Claims(&claims); err != nil {
+			return err
+		}
+		if claims.IntrospectionEndpoint == "" {
+			return errors.New("introspection_endpoint is not provided by the OIDC provider")
+		}
+		conf.introspectionEndpoint = claims.IntrospectionEndpoint
+	}
+
+	conf.audiences = bearer.GetAudiences()
+	conf.verifier = provider.Verifier(&oidc.Config{
+		// check the audiences by ourselves
+		SkipClientIDCheck: true,
+	})
 	return nil
 }
@@ -26,9 +26,11 @@ import (
 
 func TestBadIssuer(t *testing.T) {
 	c := config{
-		Config: oidc.Config{
-			Issuer:  "http://1.1.1.1",
-			Timeout: &durationpb.Duration{Seconds: 1}, // quick fail
+		CustomConfig: oidc.CustomConfig{
+			Config: oidc.Config{
+				Issuer:  "http://1.1.1.1",
+				Timeout: &durationpb.Duration{Seconds: 1}, // quick fail
+			},
 		},
 	}
 	err := c.Init(nil)
@@ -37,9 +39,11 @@ func TestBadIssuer(t *testing.T) {
 
 func TestDefaultValue(t *testing.T) {
 	c := config{
-		Config: oidc.Config{
-			Issuer:  "http://1.1.1.1",
-			Timeout: &durationpb.Duration{Seconds: 1}, // quick fail
+		CustomConfig: oidc.CustomConfig{
+			Config: oidc.Config{
+				Issuer:  "http://1.1.1.1",
+				Timeout: &durationpb.Duration{Seconds: 1}, // quick fail
+			},
 		},
 	}
 	// we set default value before communicating with the issuer
@@ -62,6 +66,77 @@ func TestConfig(t *testing.T) {
 			name:  "leeway can be 0s",
 			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "accessTokenRefreshLeeway":"0s"}`,
 		},
+		{
+			name:  "SameSite=None requires secure",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookie":{"sameSite":"NONE"}}`,
+			err:   "cookie with SameSite=None must be secure",
+		},
+		{
+			name:  "cookie",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookie":{"sameSite":"NONE", "secure":true, "domain":"example.com", "path":"/"}}`,
+		},
+		{
+			name:  "redis source is required",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "session":{"redis":{}}}`,
+			err:   "invalid Redis.Source: value is required",
+		},
+		{
+			name:  "bad logout path",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "logout":{"path":"logout"}}`,
+			err:   "invalid Logout.Path",
+		},
+		{
+			name:  "bad post logout redirect url",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "logout":{"path":"/logout", "postLogoutRedirectUrl":"/"}}`,
+			err:   "invalid Logout.PostLogoutRedirectUrl",
+		},
+		{
+			name:  "redirect url is required in authorization code mode",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com"}`,
+			err:   "redirect_url is required in AUTHORIZATION_CODE mode",
+		},
+		{
+			name:  "public client requires cookie secret",
+			input: `{"clientId":"a", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo"}`,
+			err:   "cookie_secret is required when client_secret is not set",
+		},
+		{
+			name:  "short cookie secret",
+			input: `{"clientId":"a", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookieSecret":"short"}`,
+			err:   "invalid Config.CookieSecret",
+		},
+		{
+			name:  "public client",
+			input: `{"clientId":"a", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookieSecret":"0123456789abcdef"}`,
+		},
+		{
+			name:  "bearer mode",
+			input: `{"clientId":"a", "issuer":"https://google.com", "mode":"BEARER", "bearer":{"audiences":["api"]}}`,
+		},
+		{
+			name:  "bearer mode requires audiences",
+			input: `{"clientId":"a", "issuer":"https://google.com", "mode":"BEARER"}`,
+			err:   "bearer.audiences is required",
+		},
+		{
+			name:  "introspection",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "mode":"BEARER", "bearer":{"introspection":true, "audiences":["api"]}}`,
+		},
+		{
+			name:  "introspection requires audiences",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "mode":"BEARER", "bearer":{"introspection":true}}`,
+			err:   "invalid Bearer.Audiences",
+		},
+		{
+			name:  "introspection requires client secret",
+			input: `{"clientId":"a", "issuer":"https://google.com", "mode":"BEARER", "bearer":{"introspection":true, "audiences":["api"]}}`,
+			err:   "client_secret is required to use token introspection",
+		},
+		{
+			name:  "bad claim header",
+			input: `{"clientId":"a", "issuer":"https://google.com", "mode":"BEARER", "bearer":{"audiences":["api"]}, "claimsToHeaders":[{"claim":"sub", "header":"x user"}]}`,
+			err:   "invalid ClaimToHeader.Header",
+		},
 	}
 
 	for _, tt := range tests {
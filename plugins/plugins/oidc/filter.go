@@ -20,6 +20,8 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -29,8 +31,12 @@ import (
 	"golang.org/x/oauth2"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/bearer"
+	oidctype "mosn.io/htnn/types/plugins/oidc"
 )
 
+var errBadCookie = errors.New("bad oidc cookie")
+
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &filter{
 		callbacks: callbacks,
@@ -79,6 +85,32 @@ func (f *filter) CookieName(key string) string {
 	return fmt.Sprintf("htnn_oidc_%s_%s", key, f.config.cookieEntryID)
 }
 
+func (f *filter) newCookie(name string, value string, maxAge int) *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+	}
+
+	attrs := f.config.Cookie
+	if attrs == nil {
+		return cookie
+	}
+	cookie.Domain = attrs.Domain
+	cookie.Path = attrs.Path
+	cookie.Secure = attrs.Secure
+	switch attrs.SameSite {
+	case oidctype.SameSite_LAX:
+		cookie.SameSite = http.SameSiteLaxMode
+	case oidctype.SameSite_STRICT:
+		cookie.SameSite = http.SameSiteStrictMode
+	case oidctype.SameSite_NONE:
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+	return cookie
+}
+
 func (f *filter) handleInitRequest(headers api.RequestHeaderMap) api.ResultAction {
 	config := f.config
 	o2conf := config.oauth2Config
@@ -88,7 +120,7 @@ func (f *filter) handleInitRequest(headers api.RequestHeaderMap) api.ResultActio
 	nonce := base64.RawURLEncoding.EncodeToString(b)
 	verifier := oauth2.GenerateVerifier()
 	originURL := fmt.Sprintf("%s://%s%s", headers.Scheme(), headers.Host(), headers.Path())
-	s := generateState(verifier, config.ClientSecret, originURL)
+	s := generateState(verifier, config.secret(), originURL)
 	url := o2conf.AuthCodeURL(s,
 		// use PKCE to protect against CSRF attacks if possible
 		// https://www.ietf.org/archive/id/draft-ietf-oauth-security-topics-22.html#name-countermeasures-6
@@ -101,12 +133,11 @@ func (f *filter) handleInitRequest(headers api.RequestHeaderMap) api.ResultActio
 		api.LogErrorf("failed to encode cookie: %v", err)
 		return &api.LocalResponse{Code: 503, Msg: "failed to encode cookie"}
 	}
-	cookieNonce := &http.Cookie{
-		Name:     cookieName,
-		Value:    n,
-		MaxAge:   int(time.Hour.Seconds()),
-		HttpOnly: true,
-		// TODO: allow configuring the cookie attributes
+	cookieNonce := f.newCookie(cookieName, n, int(time.Hour.Seconds()))
+	if cookieNonce.SameSite == http.SameSiteStrictMode {
+		// The nonce cookie needs to be sent in the redirection from the OIDC provider,
+		// which is a cross-site request.
+		cookieNonce.SameSite = http.SameSiteLaxMode
 	}
 
 	return &api.LocalResponse{
@@ -156,7 +187,7 @@ func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values)
 	// 1. sign the state to avoid being forged by the attacker
 	// 2. use PKCE to ensure the code is bound with the state, which is trusted after being verified
 	// 3. use nonce to ensure the id token is coming from the authorization request we initiated
-	if !verifyState(state, config.ClientSecret) {
+	if !verifyState(state, config.secret()) {
 		api.LogInfof("bad state: %s", state)
 		return &api.LocalResponse{Code: 403, Msg: "bad state"}
 	}
@@ -203,7 +234,7 @@ func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values)
 		}
 	}
 
-	cookie, err := f.saveTokenAsCookie(ctx, oauth2Token, rawIDToken)
+	cookie, err := f.saveToken(ctx, oauth2Token, rawIDToken, "")
 	if err != nil {
 		return &api.LocalResponse{Code: 503, Msg: "failed to save token"}
 	}
@@ -217,16 +248,57 @@ func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values)
 	}
 }
 
+// loadTokens returns the tokens and the session ID (if the session store is configured) from the cookie.
+// The returned tokens are nil if the session is not found.
+func (f *filter) loadTokens(ctx context.Context, encodedToken string) (*Tokens, string, error) {
+	config := f.config
+	cookieName := f.CookieName("token")
+	if config.sessions == nil {
+		tokens := &Tokens{}
+		err := config.cookieEncoding.Decode(cookieName, encodedToken, tokens)
+		if err != nil {
+			api.LogInfof("bad oidc cookie: %s, err: %v", encodedToken, err)
+			return nil, "", errBadCookie
+		}
+		return tokens, "", nil
+	}
+
+	var sessionID string
+	err := config.cookieEncoding.Decode(cookieName, encodedToken, &sessionID)
+	if err != nil {
+		api.LogInfof("bad oidc cookie: %s, err: %v", encodedToken, err)
+		return nil, "", errBadCookie
+	}
+	data, found, err := config.sessions.Get(ctx, sessionID)
+	if err != nil {
+		return nil, sessionID, err
+	}
+	if !found {
+		return nil, sessionID, nil
+	}
+	tokens := &Tokens{}
+	err = json.Unmarshal([]byte(data), tokens)
+	if err != nil {
+		return nil, sessionID, err
+	}
+	return tokens, sessionID, nil
+}
+
 func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedToken string) api.ResultAction {
 	config := f.config
 	ctx := context.Background()
 
-	tokens := &Tokens{}
-	cookieName := f.CookieName("token")
-	err := config.cookieEncoding.Decode(cookieName, encodedToken, tokens)
+	tokens, sessionID, err := f.loadTokens(ctx, encodedToken)
 	if err != nil {
-		api.LogInfof("bad oidc cookie: %s, err: %v", encodedToken, err)
-		return &api.LocalResponse{Code: 403, Msg: "bad oidc cookie"}
+		if errors.Is(err, errBadCookie) {
+			return &api.LocalResponse{Code: 403, Msg: "bad oidc cookie"}
+		}
+		api.LogErrorf("failed to load session %s: %v", sessionID, err)
+		return &api.LocalResponse{Code: 503, Msg: "failed to load session"}
+	}
+	if tokens == nil {
+		api.LogInfof("session %s not found, re-authenticate", sessionID)
+		return f.handleInitRequest(headers)
 	}
 
 	oauth2Token := tokens.Oauth2Token
@@ -249,7 +321,7 @@ func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedToken string) a
 				rawIDToken = newIDToken
 			}
 
-			f.tokenCookie, err = f.saveTokenAsCookie(ctx, possibleRefreshedToken, rawIDToken)
+			f.tokenCookie, err = f.saveToken(ctx, possibleRefreshedToken, rawIDToken, sessionID)
 			if err != nil {
 				return &api.LocalResponse{Code: 503, Msg: "failed to save token"}
 			}
@@ -265,10 +337,120 @@ func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedToken string) a
 
 	headers.Set("authorization", fmt.Sprintf("%s %s", oauth2Token.Type(), oauth2Token.AccessToken))
 	headers.Set(config.IdTokenHeader, rawIDToken)
+
+	if len(config.ClaimsToHeaders) == 0 && config.ConsumerClaim == "" {
+		return api.Continue
+	}
+	// The ID token has been verified before saving it
+	claims, err := parseJWTClaims(rawIDToken)
+	if err != nil {
+		api.LogErrorf("failed to parse claims of id token: %v", err)
+		return &api.LocalResponse{Code: 503, Msg: "bad id token"}
+	}
+	return f.applyClaims(headers, claims)
+}
+
+func parseJWTClaims(raw string) (map[string]any, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed jwt")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// applyClaims finds the consumer and forwards the claims to the upstream
+func (f *filter) applyClaims(headers api.RequestHeaderMap, claims map[string]any) api.ResultAction {
+	config := f.config
+	if config.ConsumerClaim != "" {
+		value, ok := claims[config.ConsumerClaim].(string)
+		if !ok {
+			api.LogInfof("claim %s is missing or not a string", config.ConsumerClaim)
+			return &api.LocalResponse{Code: 401, Msg: "consumer not found"}
+		}
+		c, ok := f.callbacks.LookupConsumer(oidctype.Name, value)
+		if !ok {
+			api.LogInfof("consumer with claim %s=%s not found", config.ConsumerClaim, value)
+			return &api.LocalResponse{Code: 401, Msg: "consumer not found"}
+		}
+		f.callbacks.SetConsumer(c)
+	}
+
+	bearer.SetClaimsToHeaders(headers, claims, config.ClaimsToHeaders)
 	return api.Continue
 }
 
+func (f *filter) handleLogout(headers api.RequestHeaderMap) api.ResultAction {
+	config := f.config
+	ctx := context.Background()
+	cookieName := f.CookieName("token")
+
+	var rawIDToken string
+	if token := headers.Cookie(cookieName); token != nil {
+		tokens, sessionID, err := f.loadTokens(ctx, token.Value)
+		if err != nil {
+			api.LogInfof("failed to load tokens during logout: %v", err)
+		} else if tokens != nil {
+			rawIDToken = tokens.IDToken
+		}
+		if sessionID != "" {
+			if err := config.sessions.Delete(ctx, sessionID); err != nil {
+				api.LogErrorf("failed to delete session %s: %v", sessionID, err)
+			}
+		}
+	}
+
+	hdr := http.Header{}
+	hdr.Set("Set-Cookie", f.newCookie(cookieName, "", -1).String())
+
+	location := config.Logout.PostLogoutRedirectUrl
+	if config.endSessionEndpoint != "" {
+		// RP-initiated logout: https://openid.net/specs/openid-connect-rpinitiated-1_0.html
+		u, err := url.Parse(config.endSessionEndpoint)
+		if err != nil {
+			api.LogErrorf("bad end_session_endpoint %s: %v", config.endSessionEndpoint, err)
+			return &api.LocalResponse{Code: 503, Msg: "bad end_session_endpoint"}
+		}
+		query := u.Query()
+		query.Set("client_id", config.ClientId)
+		if rawIDToken != "" {
+			query.Set("id_token_hint", rawIDToken)
+		}
+		if location != "" {
+			query.Set("post_logout_redirect_uri", location)
+		}
+		u.RawQuery = query.Encode()
+		location = u.String()
+	}
+
+	if location == "" {
+		return &api.LocalResponse{Code: http.StatusOK, Msg: "logged out", Header: hdr}
+	}
+	hdr.Set("Location", location)
+	return &api.LocalResponse{Code: http.StatusFound, Header: hdr}
+}
+
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	// remove the headers from the client to prevent spoofing
+	for _, ch := range f.config.ClaimsToHeaders {
+		headers.Del(ch.Header)
+	}
+
+	if f.config.Mode == oidctype.Mode_BEARER {
+		return f.handleBearer(headers)
+	}
+
+	if logout := f.config.Logout; logout != nil && headers.URL().Path == logout.Path {
+		return f.handleLogout(headers)
+	}
+
 	cookieName := f.CookieName("token")
 	token := headers.Cookie(cookieName)
 	if token != nil {
@@ -284,30 +466,54 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 	return f.handleCallback(headers, query)
 }
 
-func (f *filter) saveTokenAsCookie(ctx context.Context, oauth2Token *oauth2.Token, rawIDToken string) (*http.Cookie, error) {
-	idToken, err := f.config.verifier.Verify(ctx, rawIDToken)
+func generateSessionID() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// saveToken saves the tokens into the cookie, or into the session store if it's configured.
+// An empty sessionID means a new session will be created.
+func (f *filter) saveToken(ctx context.Context, oauth2Token *oauth2.Token, rawIDToken string, sessionID string) (*http.Cookie, error) {
+	config := f.config
+	idToken, err := config.verifier.Verify(ctx, rawIDToken)
 	if err != nil {
 		api.LogErrorf("bad token: %v", err)
 		return nil, err
 	}
 
-	cookieName := f.CookieName("token")
-	token, err := f.config.cookieEncoding.Encode(cookieName, Tokens{
+	ttl := f.calculateTokenTTL(oauth2Token.Expiry, idToken.Expiry, f.refreshEnabled(oauth2Token))
+	tokens := Tokens{
 		Oauth2Token: oauth2Token,
 		IDToken:     rawIDToken,
-	})
+	}
+	cookieName := f.CookieName("token")
+	var token string
+	if config.sessions == nil {
+		token, err = config.cookieEncoding.Encode(cookieName, tokens)
+	} else {
+		if sessionID == "" {
+			sessionID = generateSessionID()
+		}
+		var data []byte
+		data, err = json.Marshal(tokens)
+		if err != nil {
+			return nil, err
+		}
+		// the expired session will be removed by the store
+		err = config.sessions.Set(ctx, sessionID, string(data), time.Duration(max(ttl, 1))*time.Second)
+		if err != nil {
+			api.LogErrorf("failed to save session: %v", err)
+			return nil, err
+		}
+		token, err = config.cookieEncoding.Encode(cookieName, sessionID)
+	}
 	if err != nil {
 		api.LogErrorf("failed to encode cookie: %v", err)
 		return nil, err
 	}
 
-	ttl := f.calculateTokenTTL(oauth2Token.Expiry, idToken.Expiry, f.refreshEnabled(oauth2Token))
-	cookie := &http.Cookie{
-		Name:     cookieName,
-		Value:    token,
-		MaxAge:   ttl,
-		HttpOnly: true,
-	}
+	cookie := f.newCookie(cookieName, token, ttl)
 
 	api.LogInfof("token saved as cookie %+v, client id: %s", cookie, f.config.ClientId)
 	return cookie, nil
@@ -15,6 +15,8 @@
 package oidc
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strings"
@@ -25,6 +27,7 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gorilla/securecookie"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
@@ -34,11 +37,13 @@ import (
 
 func getCfg() *config {
 	return &config{
-		Config: oidctype.Config{
-			ClientId:      "9119df09-b20b-4c08-ba08-72472dda2cd2",
-			ClientSecret:  "dSYo5hBwjX_DC57_tfZHlfrDel",
-			RedirectUrl:   "http://127.0.0.1:10000",
-			IdTokenHeader: "my-id-token",
+		CustomConfig: oidctype.CustomConfig{
+			Config: oidctype.Config{
+				ClientId:      "9119df09-b20b-4c08-ba08-72472dda2cd2",
+				ClientSecret:  "dSYo5hBwjX_DC57_tfZHlfrDel",
+				RedirectUrl:   "http://127.0.0.1:10000",
+				IdTokenHeader: "my-id-token",
+			},
 		},
 		oauth2Config:   &oauth2.Config{},
 		verifier:       &oidc.IDTokenVerifier{},
@@ -386,3 +391,175 @@ func TestAttachInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestCookieAttributes(t *testing.T) {
+	conf := getCfg()
+	conf.Cookie = &oidctype.Cookie{
+		Domain:   "example.com",
+		Path:     "/",
+		SameSite: oidctype.SameSite_STRICT,
+		Secure:   true,
+	}
+
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb).(*filter)
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	resp := f.DecodeHeaders(hdr, true).(*api.LocalResponse)
+	cookie := resp.Header.Get("Set-Cookie")
+	assert.Regexp(t, `^htnn_oidc_nonce_id=[^;]+; Path=/; Domain=example.com; Max-Age=3600; HttpOnly; Secure; SameSite=Lax$`, cookie)
+
+	c := f.newCookie("htnn_oidc_token_id", "v", 60)
+	assert.Equal(t, "htnn_oidc_token_id=v; Path=/; Domain=example.com; Max-Age=60; HttpOnly; Secure; SameSite=Strict", c.String())
+}
+
+func getSessionCfg(t *testing.T) *config {
+	conf := getCfg()
+	conf.Session = &oidctype.Session{}
+	sessions := newMemorySessionStore()
+	t.Cleanup(sessions.Close)
+	conf.sessions = sessions
+	return conf
+}
+
+func TestSession(t *testing.T) {
+	conf := getSessionCfg(t)
+	verifier := oauth2.GenerateVerifier()
+	state := generateState(verifier, conf.ClientSecret, "https://127.0.0.1:2379/x?y=1")
+	token := (&oauth2.Token{
+		AccessToken:  "accessToken",
+		RefreshToken: "refreshToken",
+		Expiry:       time.Now().Add(time.Hour),
+	}).WithExtra(map[string]interface{}{
+		"id_token": "rawIDToken",
+	})
+	nonce, _ := conf.cookieEncoding.Encode("htnn_oidc_nonce_id", "xxx")
+
+	patches := gomonkey.ApplyMethodReturn(conf.oauth2Config, "Exchange", token, nil)
+	patches.ApplyMethodReturn(conf.verifier, "Verify", &oidc.IDToken{
+		Nonce: "xxx", Expiry: time.Now().Add(2 * time.Hour),
+	}, nil)
+	defer patches.Reset()
+
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb).(*filter)
+	h := http.Header{}
+	h.Set(":path", "/echo?code=123&state="+state)
+	h.Set("cookie", "htnn_oidc_nonce_id="+nonce)
+	resp := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true).(*api.LocalResponse)
+	require.Equal(t, http.StatusFound, resp.Code, resp.Msg)
+	cookie := resp.Header.Get("Set-Cookie")
+	assert.Contains(t, cookie, "Max-Age=7199;")
+
+	// only the session ID is stored in the cookie
+	v := strings.SplitN(strings.Split(cookie, ";")[0], "=", 2)[1]
+	var sessionID string
+	require.NoError(t, conf.cookieEncoding.Decode("htnn_oidc_token_id", v, &sessionID))
+	data, found, err := conf.sessions.Get(context.Background(), sessionID)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Contains(t, data, "refreshToken")
+
+	patches.ApplyMethodReturn(conf.oauth2Config, "TokenSource", &mockTokenSource{})
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.Continue, f.attachInfo(hdr, v))
+	bearer, _ := hdr.Get("authorization")
+	assert.Equal(t, "Bearer accessToken", bearer)
+
+	// refresh token in the same session
+	expiredToken, _ := json.Marshal(Tokens{
+		Oauth2Token: &oauth2.Token{
+			AccessToken:  "expiredToken",
+			Expiry:       time.Now().Add(-1 * time.Hour),
+			RefreshToken: "refreshToken",
+		},
+		IDToken: "rawIDToken",
+	})
+	require.NoError(t, conf.sessions.Set(context.Background(), sessionID, string(expiredToken), time.Minute))
+	tkSrc := &mockTokenSource{}
+	patches.ApplyMethodReturn(conf.oauth2Config, "TokenSource", tkSrc)
+	patches.ApplyMethodReturn(tkSrc, "Token", (&oauth2.Token{
+		AccessToken: "accessToken2",
+		Expiry:      time.Now().Add(time.Hour),
+	}).WithExtra(map[string]interface{}{
+		"id_token": "rawIDToken2",
+	}), nil)
+	f = factory(conf, cb).(*filter)
+	hdr = envoy.NewRequestHeaderMap(http.Header{})
+	assert.Equal(t, api.Continue, f.attachInfo(hdr, v))
+	bearer, _ = hdr.Get("authorization")
+	assert.Equal(t, "Bearer accessToken2", bearer)
+	require.NotNil(t, f.tokenCookie)
+	assert.Equal(t, v, f.tokenCookie.Value)
+	data, _, _ = conf.sessions.Get(context.Background(), sessionID)
+	assert.Contains(t, data, "accessToken2")
+
+	// session is gone, re-authenticate
+	require.NoError(t, conf.sessions.Delete(context.Background(), sessionID))
+	res := f.attachInfo(envoy.NewRequestHeaderMap(http.Header{}), v)
+	resp = res.(*api.LocalResponse)
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), "htnn_oidc_nonce_id=")
+
+	// the cookie is for the stateless mode
+	stateless, _ := conf.cookieEncoding.Encode("htnn_oidc_token_id", Tokens{IDToken: "rawIDToken"})
+	res = f.attachInfo(envoy.NewRequestHeaderMap(http.Header{}), stateless)
+	assert.Equal(t, &api.LocalResponse{Code: 403, Msg: "bad oidc cookie"}, res)
+}
+
+func TestLogout(t *testing.T) {
+	tests := []struct {
+		name               string
+		endSessionEndpoint string
+		postLogoutURL      string
+		code               int
+		location           string
+	}{
+		{
+			name:               "rp-initiated logout",
+			endSessionEndpoint: "https://op.example.com/logout?x=1",
+			postLogoutURL:      "https://app.example.com/",
+			code:               http.StatusFound,
+			location:           "https://op.example.com/logout?client_id=9119df09-b20b-4c08-ba08-72472dda2cd2&id_token_hint=rawIDToken&post_logout_redirect_uri=https%3A%2F%2Fapp.example.com%2F&x=1",
+		},
+		{
+			name:          "redirect",
+			postLogoutURL: "https://app.example.com/",
+			code:          http.StatusFound,
+			location:      "https://app.example.com/",
+		},
+		{
+			name: "no redirect",
+			code: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := getSessionCfg(t)
+			conf.Logout = &oidctype.Logout{
+				Path:                  "/logout",
+				PostLogoutRedirectUrl: tt.postLogoutURL,
+			}
+			conf.Cookie = &oidctype.Cookie{Path: "/"}
+			conf.endSessionEndpoint = tt.endSessionEndpoint
+
+			ctx := context.Background()
+			require.NoError(t, conf.sessions.Set(ctx, "session", `{"id_token":"rawIDToken"}`, time.Minute))
+			v, _ := conf.cookieEncoding.Encode("htnn_oidc_token_id", "session")
+
+			cb := envoy.NewFilterCallbackHandler()
+			f := factory(conf, cb).(*filter)
+			h := http.Header{}
+			h.Set(":path", "/logout?from=menu")
+			h.Set("cookie", "htnn_oidc_token_id="+v)
+			resp := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true).(*api.LocalResponse)
+			assert.Equal(t, tt.code, resp.Code)
+			assert.Equal(t, tt.location, resp.Header.Get("Location"))
+			assert.Equal(t, "htnn_oidc_token_id=; Path=/; Max-Age=0; HttpOnly", resp.Header.Get("Set-Cookie"))
+
+			_, found, err := conf.sessions.Get(ctx, "session")
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/redis/go-redis/v9"
+
+	"mosn.io/htnn/plugins/pkg/redisx"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// sessionStore keeps the tokens in the server side, so that only the session ID is stored in the cookie
+type sessionStore interface {
+	// Get returns false if the session is not found or expired
+	Get(ctx context.Context, id string) (string, bool, error)
+	Set(ctx context.Context, id string, data string, ttl time.Duration) error
+	Delete(ctx context.Context, id string) error
+	Close()
+}
+
+type memorySessionStore struct {
+	sessions *ttlcache.Cache[string, string]
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	sessions := ttlcache.New(
+		ttlcache.WithDisableTouchOnHit[string, string](),
+	)
+	go sessions.Start()
+	return &memorySessionStore{sessions: sessions}
+}
+
+func (s *memorySessionStore) Get(_ context.Context, id string) (string, bool, error) {
+	item := s.sessions.Get(id)
+	if item == nil {
+		return "", false, nil
+	}
+	return item.Value(), true, nil
+}
+
+func (s *memorySessionStore) Set(_ context.Context, id string, data string, ttl time.Duration) error {
+	s.sessions.Set(id, data, ttl)
+	return nil
+}
+
+func (s *memorySessionStore) Delete(_ context.Context, id string) error {
+	s.sessions.Delete(id)
+	return nil
+}
+
+func (s *memorySessionStore) Close() {
+	s.sessions.Stop()
+}
+
+type redisSessionStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func newRedisSessionStore(conf *v1.Redis) *redisSessionStore {
+	client := redisx.NewClientFromConfig(conf)
+
+	prefix := conf.Prefix
+	if prefix == "" {
+		prefix = "htnn_oidc_session"
+	}
+	return &redisSessionStore{client: client, prefix: prefix}
+}
+
+func (s *redisSessionStore) key(id string) string {
+	return s.prefix + "|" + id
+}
+
+func (s *redisSessionStore) Get(ctx context.Context, id string) (string, bool, error) {
+	data, err := s.client.Get(ctx, s.key(id)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return data, true, nil
+}
+
+func (s *redisSessionStore) Set(ctx context.Context, id string, data string, ttl time.Duration) error {
+	return s.client.Set(ctx, s.key(id), data, ttl).Err()
+}
+
+func (s *redisSessionStore) Delete(ctx context.Context, id string) error {
+	return s.client.Del(ctx, s.key(id)).Err()
+}
+
+func (s *redisSessionStore) Close() {
+	s.client.Close()
+}
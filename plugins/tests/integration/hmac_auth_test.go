@@ -15,7 +15,11 @@
 package integration
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,6 +89,45 @@ func TestHmacAuth(t *testing.T) {
 				assert.Equal(t, 401, resp.StatusCode)
 			},
 		},
+		{
+			name: "body digest",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "hmacAuth",
+					Config: map[string]interface{}{
+						"signatureHeader": "x-sign-hdr",
+						"accessKeyHeader": "x-ak",
+						"dateHeader":      "x-date",
+						"bodyDigest":      map[string]interface{}{},
+					},
+				},
+				{
+					Name: "consumerRestriction",
+					Config: map[string]interface{}{
+						"deny_if_no_consumer": true,
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				body := "the body"
+				sum := sha256.Sum256([]byte(body))
+				digest := "sha-256=:" + base64.StdEncoding.EncodeToString(sum[:]) + ":"
+				date := "Fri Jan  5 16:10:54 CST 2024"
+				mac := hmac.New(sha256.New, []byte("sk"))
+				mac.Write([]byte("POST\n/echo\n\nak\n" + date + "\n" + digest + "\nx-custom-a:test\n"))
+
+				hdr := http.Header{}
+				hdr.Set("x-sign-hdr", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+				hdr.Set("x-ak", "ak")
+				hdr.Set("x-date", date)
+				hdr.Set("x-custom-a", "test")
+				hdr.Set("content-digest", digest)
+				resp, _ := dp.Post("/echo", hdr, strings.NewReader(body))
+				assert.Equal(t, 200, resp.StatusCode)
+				resp, _ = dp.Post("/echo", hdr, strings.NewReader("tampered"))
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
 		{
 			name: "bypass if no credential",
 			config: controlplane.NewPluginConfig([]*model.FilterConfig{
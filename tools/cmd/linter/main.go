@@ -339,7 +339,15 @@ func parseField(fs map[string]Field, field *parser.Field) {
 		}
 	}
 
-	if field.IsRequired || (len(field.FieldOptions) > 0 && !field.IsRepeated && field.Type != "google.protobuf.Duration") {
+	hasValidateRules := false
+	for _, option := range field.FieldOptions {
+		// other options like `(api.v1.cel)` don't affect the requirement
+		if strings.HasPrefix(option.OptionName, "(validate.") {
+			hasValidateRules = true
+			break
+		}
+	}
+	if field.IsRequired || (hasValidateRules && !field.IsRepeated && field.Type != "google.protobuf.Duration") {
 		f.Required = true
 	}
 
@@ -398,6 +406,12 @@ func parseMessage(ms map[string]Message, msg *parser.Message) {
 		case *parser.Oneof:
 			for _, f := range field.OneofFields {
 				parseField(m.Fields, exactCommonField(f, len(field.OneofFields)))
+				name := snakeToCamel(f.FieldName)
+				if fd, ok := m.Fields[name]; ok && len(field.OneofFields) > 1 {
+					// the validation rules only apply when this field is chosen
+					fd.Required = false
+					m.Fields[name] = fd
+				}
 			}
 		}
 	}
@@ -23,14 +23,17 @@ import (
 type ConditionType string
 
 const (
-	ConditionAccepted ConditionType = "Accepted"
+	ConditionAccepted   ConditionType = "Accepted"
+	ConditionProgrammed ConditionType = "Programmed"
 )
 
 type ConditionReason string
 
 const (
-	ReasonAccepted ConditionReason = "Accepted"
-	ReasonInvalid  ConditionReason = "Invalid"
+	ReasonAccepted   ConditionReason = "Accepted"
+	ReasonInvalid    ConditionReason = "Invalid"
+	ReasonProgrammed ConditionReason = "Programmed"
+	ReasonFailed     ConditionReason = "Failed"
 )
 
 func needUpdateCondition(a, b metav1.Condition) bool {
@@ -87,6 +90,29 @@ func addOrUpdateAcceptedCondition(conditions []metav1.Condition,
 	return addOrUpdateCondition(conditions, c)
 }
 
+func addOrUpdateProgrammedCondition(conditions []metav1.Condition,
+	observedGeneration int64, reason ConditionReason, msg ...string) ([]metav1.Condition, bool) {
+
+	c := metav1.Condition{
+		Type:               string(ConditionProgrammed),
+		Reason:             string(reason),
+		LastTransitionTime: metav1.NewTime(time.Now()),
+		ObservedGeneration: observedGeneration,
+	}
+	switch reason {
+	case ReasonProgrammed:
+		c.Status = metav1.ConditionTrue
+		c.Message = "The resource has been programmed in the data plane"
+	case ReasonFailed:
+		c.Status = metav1.ConditionFalse
+		c.Message = "The resource failed to be programmed in the data plane"
+	}
+	if len(msg) > 0 {
+		c.Message = msg[0]
+	}
+	return addOrUpdateCondition(conditions, c)
+}
+
 type ChangeDetector struct {
 	changed bool
 }
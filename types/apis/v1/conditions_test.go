@@ -15,6 +15,8 @@
 package v1
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -50,3 +52,106 @@ func TestAddOrUpdate(t *testing.T) {
 	assert.Equal(t, update, p.Status.Conditions[0])
 	assert.True(t, changed)
 }
+
+func TestSetProgrammed(t *testing.T) {
+	c := &DynamicConfig{}
+	c.Generation = 2
+	c.SetProgrammed(ReasonFailed, "gateway-0: bad config")
+	assert.True(t, c.Status.IsChanged())
+	assert.Equal(t, 1, len(c.Status.Conditions))
+	cond := c.Status.Conditions[0]
+	assert.Equal(t, string(ConditionProgrammed), cond.Type)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "gateway-0: bad config", cond.Message)
+	assert.Equal(t, int64(2), cond.ObservedGeneration)
+	// failing to program the resource doesn't make it invalid
+	assert.True(t, c.IsValid())
+
+	c.Status.Reset()
+	c.SetProgrammed(ReasonProgrammed)
+	assert.True(t, c.Status.IsChanged())
+	assert.Equal(t, 1, len(c.Status.Conditions))
+	assert.Equal(t, metav1.ConditionTrue, c.Status.Conditions[0].Status)
+}
+
+func TestRecordRevision(t *testing.T) {
+	c := &DynamicConfig{}
+	for i := 1; i <= MaxDynamicConfigRevisions+2; i++ {
+		c.Generation = int64(i)
+		c.Spec.Config.Raw = []byte(fmt.Sprintf(`{"key":"%d"}`, i))
+		c.RecordRevision()
+		// recording the same generation twice is a no-op
+		c.RecordRevision()
+	}
+	assert.True(t, c.Status.IsChanged())
+	assert.Equal(t, MaxDynamicConfigRevisions, len(c.Status.Revisions))
+	assert.Equal(t, int64(3), c.Status.Revisions[0].Generation)
+	assert.Nil(t, c.Revision(2))
+
+	rev := c.Revision(int64(MaxDynamicConfigRevisions + 2))
+	assert.Equal(t, HashDynamicConfig([]byte(`{"key":"12"}`)), rev.ConfigHash)
+	assert.Equal(t, `{"key":"12"}`, string(rev.Config.Raw))
+	assert.Equal(t, 64, len(rev.ConfigHash))
+	assert.False(t, rev.Programmed)
+
+	c.Status.Reset()
+	c.SetProgrammed(ReasonFailed)
+	assert.False(t, rev.Programmed)
+	c.SetProgrammed(ReasonProgrammed)
+	assert.True(t, rev.Programmed)
+	assert.True(t, c.Status.IsChanged())
+	assert.False(t, c.Revision(int64(MaxDynamicConfigRevisions+1)).Programmed)
+}
+
+func TestRecordRevisionDropLargeConfig(t *testing.T) {
+	c := &DynamicConfig{}
+	value := strings.Repeat("v", MaxDynamicConfigRevisionsConfigSize/3)
+	for i := 1; i <= 4; i++ {
+		c.Generation = int64(i)
+		c.Spec.Config.Raw = []byte(fmt.Sprintf(`{"key":"%s%d"}`, value, i))
+		c.RecordRevision()
+	}
+	assert.Equal(t, 4, len(c.Status.Revisions))
+	// only the configurations of the newest revisions are kept
+	assert.Nil(t, c.Revision(1).Config)
+	assert.Nil(t, c.Revision(2).Config)
+	assert.NotNil(t, c.Revision(3).Config)
+	assert.Equal(t, string(c.Spec.Config.Raw), string(c.Revision(4).Config.Raw))
+	// the hash is kept even if the configuration is dropped
+	assert.Equal(t, 64, len(c.Revision(1).ConfigHash))
+}
+
+func TestRecordDataPlaneStatus(t *testing.T) {
+	c := &DynamicConfig{}
+	c.Generation = 2
+	c.RecordRevision()
+	c.Status.DataPlanes = []DynamicConfigDataPlaneStatus{
+		{Node: "ns/gw-2", ObservedGeneration: 1},
+	}
+
+	assert.False(t, c.RecordDataPlaneStatus("ns/gw-0", 1, ""))
+	assert.True(t, c.RecordDataPlaneStatus("ns/gw-0", 2, "ouch"))
+	assert.True(t, c.RecordDataPlaneStatus("ns/gw-1", 2, ""))
+	assert.True(t, c.Status.IsChanged())
+	// the report of the previous generation is dropped
+	assert.Equal(t, 2, len(c.Status.DataPlanes))
+	assert.Equal(t, "ns/gw-0", c.Status.DataPlanes[0].Node)
+	assert.Equal(t, "ouch", c.Status.DataPlanes[0].Error)
+	cond := c.Status.Conditions[0]
+	assert.Equal(t, string(ConditionProgrammed), cond.Type)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "failed in 1 of 2 data planes: ns/gw-0: ouch", cond.Message)
+	assert.False(t, c.Revision(2).Programmed)
+
+	assert.True(t, c.RecordDataPlaneStatus("ns/gw-0", 2, ""))
+	assert.Equal(t, 2, len(c.Status.DataPlanes))
+	cond = c.Status.Conditions[0]
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "programmed in 2 data planes", cond.Message)
+	assert.True(t, c.Revision(2).Programmed)
+
+	for i := 0; i < MaxDynamicConfigDataPlanes+1; i++ {
+		c.RecordDataPlaneStatus(fmt.Sprintf("ns/gw-%02d", i), 2, "")
+	}
+	assert.Equal(t, MaxDynamicConfigDataPlanes, len(c.Status.DataPlanes))
+}
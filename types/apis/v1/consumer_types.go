@@ -51,6 +51,24 @@ type ConsumerSpec struct {
 	//
 	// +optional
 	Name string `json:"name,omitempty"`
+
+	// Metadata is a map of arbitrary key/value pairs attached to the consumer, like the tier,
+	// the tenant or the owner team. It can be read by the plugins and the CEL expressions
+	// after the consumer is authenticated.
+	//
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// ExportTo is a list of namespaces to which this consumer is visible, in addition to its own
+	// namespace. Use "*" to make the consumer visible to all namespaces.
+	// The route's namespace still needs to import the consumer via the consumerImport plugin,
+	// and the imported consumer is named as "namespace/name".
+	// When the same credential matches multiple consumers, the consumer in the route's namespace
+	// takes precedence, then the consumer exported to the namespace explicitly, and finally the
+	// consumer exported to all namespaces.
+	//
+	// +optional
+	ExportTo []string `json:"exportTo,omitempty"`
 }
 
 // ConsumerStatus defines the observed state of Consumer
@@ -85,14 +103,16 @@ func (c *Consumer) Marshal() string {
 	}
 
 	consumer := &csModel.Consumer{
-		Auth: auth,
+		Auth:     auth,
+		Metadata: c.Spec.Metadata,
+		ExportTo: c.Spec.ExportTo,
 	}
 
 	if len(c.Spec.Filters) > 0 {
 		filters := make(map[string]*fmModel.FilterConfig, len(c.Spec.Filters))
 		for k, v := range c.Spec.Filters {
 			var config interface{}
-			// we use interface{} here because we will introduce configuration merging one day
+			// we use interface{} here because the config will be merged with the route's one in the data plane
 			_ = json.Unmarshal(v.Config.Raw, &config)
 			filters[k] = &fmModel.FilterConfig{
 				Config: config,
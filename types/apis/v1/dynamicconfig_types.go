@@ -17,6 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -36,9 +43,69 @@ type DynamicConfigStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// Revisions records the recent accepted configurations, from the oldest to the newest.
+	// It can be used to roll back the DynamicConfig to a previous configuration.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	Revisions []DynamicConfigRevision `json:"revisions,omitempty"`
+
+	// DataPlanes records the result of applying the current configuration, reported by each data plane.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=node
+	// +kubebuilder:validation:MaxItems=64
+	DataPlanes []DynamicConfigDataPlaneStatus `json:"dataPlanes,omitempty"`
+
 	ChangeDetector `json:",inline"`
 }
 
+const (
+	// MaxDynamicConfigRevisions is the number of revisions kept in the DynamicConfig status
+	MaxDynamicConfigRevisions = 10
+	// MaxDynamicConfigRevisionsConfigSize is the total size of the configurations kept in the revisions.
+	// The configurations of the older revisions are dropped once the size is exceeded, so that the
+	// status won't hit the object size limit. Their hashes are still kept.
+	MaxDynamicConfigRevisionsConfigSize = 256 * 1024
+	// MaxDynamicConfigDataPlanes is the number of data planes kept in the DynamicConfig status.
+	// The data planes which report the least recently are dropped once it is exceeded.
+	MaxDynamicConfigDataPlanes = 64
+)
+
+// DynamicConfigRevision is an accepted configuration of the DynamicConfig
+type DynamicConfigRevision struct {
+	// Generation is the generation of the DynamicConfig when the configuration is accepted
+	Generation int64 `json:"generation"`
+	// Config is the configuration of this revision. It is dropped from the older revisions
+	// when the configurations are too large to keep.
+	//
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// ConfigHash is the SHA256 hash of the configuration of this revision
+	ConfigHash string `json:"configHash"`
+	// Programmed is true if the configuration has been programmed in all the reported data planes
+	//
+	// +optional
+	Programmed bool `json:"programmed,omitempty"`
+	// CreatedAt is the time when the revision is recorded
+	CreatedAt metav1.Time `json:"createdAt"`
+}
+
+// DynamicConfigDataPlaneStatus is the result of applying the DynamicConfig in a data plane
+type DynamicConfigDataPlaneStatus struct {
+	// Node is the authenticated identity of the data plane, like "namespace/pod"
+	Node string `json:"node"`
+	// ObservedGeneration is the generation of the DynamicConfig applied in the data plane
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Error is the error returned when applying the configuration
+	//
+	// +optional
+	Error string `json:"error,omitempty"`
+	// LastReportedAt is the time when the data plane reports the status
+	LastReportedAt metav1.Time `json:"lastReportedAt"`
+}
+
 //+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
@@ -74,6 +141,122 @@ func (c *DynamicConfig) SetAccepted(reason ConditionReason, msg ...string) {
 	}
 }
 
+// SetProgrammed records the result of applying the DynamicConfig in the data plane
+func (c *DynamicConfig) SetProgrammed(reason ConditionReason, msg ...string) {
+	conds, changed := addOrUpdateProgrammedCondition(c.Status.Conditions, c.Generation, reason, msg...)
+	c.Status.Conditions = conds
+
+	if reason == ReasonProgrammed {
+		for i := range c.Status.Revisions {
+			rev := &c.Status.Revisions[i]
+			if rev.Generation == c.Generation && !rev.Programmed {
+				rev.Programmed = true
+				changed = true
+			}
+		}
+	}
+
+	if changed {
+		c.Status.MarkAsChanged()
+	}
+}
+
+// RecordDataPlaneStatus records the result of applying the current generation in the given data plane,
+// and updates the Programmed condition according to all the reported data planes. The report of
+// another generation is ignored. It returns false if nothing is recorded.
+func (c *DynamicConfig) RecordDataPlaneStatus(node string, generation int64, errMsg string) bool {
+	if generation != c.Generation {
+		return false
+	}
+
+	dataPlanes := make([]DynamicConfigDataPlaneStatus, 0, len(c.Status.DataPlanes)+1)
+	for _, dp := range c.Status.DataPlanes {
+		// the reports of the previous generations are outdated
+		if dp.ObservedGeneration == c.Generation && dp.Node != node {
+			dataPlanes = append(dataPlanes, dp)
+		}
+	}
+	dataPlanes = append(dataPlanes, DynamicConfigDataPlaneStatus{
+		Node:               node,
+		ObservedGeneration: generation,
+		Error:              errMsg,
+		LastReportedAt:     metav1.NewTime(time.Now()),
+	})
+	if len(dataPlanes) > MaxDynamicConfigDataPlanes {
+		sort.SliceStable(dataPlanes, func(i, j int) bool {
+			return dataPlanes[i].LastReportedAt.After(dataPlanes[j].LastReportedAt.Time)
+		})
+		dataPlanes = dataPlanes[:MaxDynamicConfigDataPlanes]
+	}
+	sort.Slice(dataPlanes, func(i, j int) bool {
+		return dataPlanes[i].Node < dataPlanes[j].Node
+	})
+	c.Status.DataPlanes = dataPlanes
+	c.Status.MarkAsChanged()
+
+	var failures []string
+	for _, dp := range dataPlanes {
+		if dp.Error != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", dp.Node, dp.Error))
+		}
+	}
+	if len(failures) > 0 {
+		c.SetProgrammed(ReasonFailed,
+			fmt.Sprintf("failed in %d of %d data planes: %s", len(failures), len(dataPlanes), strings.Join(failures, "; ")))
+	} else {
+		c.SetProgrammed(ReasonProgrammed, fmt.Sprintf("programmed in %d data planes", len(dataPlanes)))
+	}
+	return true
+}
+
+// RecordRevision records the current configuration as a revision if it is not recorded yet.
+// Only the latest MaxDynamicConfigRevisions revisions are kept, and only the configurations of
+// the newest revisions within MaxDynamicConfigRevisionsConfigSize are kept.
+func (c *DynamicConfig) RecordRevision() {
+	revs := c.Status.Revisions
+	if len(revs) > 0 && revs[len(revs)-1].Generation == c.Generation {
+		return
+	}
+
+	revs = append(revs, DynamicConfigRevision{
+		Generation: c.Generation,
+		Config:     c.Spec.Config.DeepCopy(),
+		ConfigHash: HashDynamicConfig(c.Spec.Config.Raw),
+		CreatedAt:  metav1.NewTime(time.Now()),
+	})
+	if len(revs) > MaxDynamicConfigRevisions {
+		revs = revs[len(revs)-MaxDynamicConfigRevisions:]
+	}
+	size := 0
+	for i := len(revs) - 1; i >= 0; i-- {
+		if revs[i].Config == nil {
+			continue
+		}
+		size += len(revs[i].Config.Raw)
+		if size > MaxDynamicConfigRevisionsConfigSize {
+			revs[i].Config = nil
+		}
+	}
+	c.Status.Revisions = revs
+	c.Status.MarkAsChanged()
+}
+
+// HashDynamicConfig returns the hash of the configuration recorded in the DynamicConfig revision
+func HashDynamicConfig(config []byte) string {
+	sum := sha256.Sum256(config)
+	return hex.EncodeToString(sum[:])
+}
+
+// Revision returns the recorded revision of the given generation, or nil if it is not found
+func (c *DynamicConfig) Revision(generation int64) *DynamicConfigRevision {
+	for i := range c.Status.Revisions {
+		if c.Status.Revisions[i].Generation == generation {
+			return &c.Status.Revisions[i]
+		}
+	}
+	return nil
+}
+
 func (c *DynamicConfig) IsValid() bool {
 	for _, cond := range c.Status.Conditions {
 		if cond.ObservedGeneration != c.Generation {
@@ -21,11 +21,15 @@ import (
 
 	istiov1a3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	csModel "mosn.io/htnn/api/pkg/consumer/model"
 	"mosn.io/htnn/api/pkg/dynamicconfig"
+	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
 	"mosn.io/htnn/types/pkg/proto"
 	"mosn.io/htnn/types/pkg/registry"
 )
@@ -121,13 +125,13 @@ func ValidateHTTPFilterPolicyStrictly(policy *HTTPFilterPolicy) error {
 	return ValidateFilterPolicyStrictly(&p)
 }
 
-func validateFilter(name string, filter Plugin, strict bool, targetGateway bool) error {
+func validateFilter(name string, filter Plugin, strict bool, targetGateway bool) (api.PluginConfig, error) {
 	p := plugins.LoadPluginType(name)
 	if p == nil {
 		if strict {
-			return errors.New("unknown http filter: " + name)
+			return nil, errors.New("unknown http filter: " + name)
 		}
-		return nil
+		return nil, nil
 	}
 
 	if targetGateway {
@@ -137,12 +141,12 @@ func validateFilter(name string, filter Plugin, strict bool, targetGateway bool)
 			// be more than 20 native plugins in the future, and it's not reasonable to provide
 			// such number (20 x the number of LDS) of ECDS resources. Perhaps we can use
 			// composite filter to solve this problem?
-			return errors.New("configure native plugins to the Gateway is not implemented")
+			return nil, errors.New("configure native plugins to the Gateway is not implemented")
 		}
 	} else {
 		switch p.Order().Position {
 		case plugins.OrderPositionListener, plugins.OrderPositionNetwork:
-			return errors.New("configure layer 4 plugins to route is invalid")
+			return nil, errors.New("configure layer 4 plugins to route is invalid")
 		}
 	}
 
@@ -155,11 +159,38 @@ func validateFilter(name string, filter Plugin, strict bool, targetGateway bool)
 		err = proto.UnmarshalJSON(data, conf)
 	}
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal for filter %s: %w", name, err)
+		return nil, fmt.Errorf("failed to unmarshal for filter %s: %w", name, err)
 	}
 
 	if err := conf.Validate(); err != nil {
-		return fmt.Errorf("invalid config for filter %s: %w", name, err)
+		return nil, fmt.Errorf("invalid config for filter %s: %w", name, err)
+	}
+	if err := expr.ValidateCelFields(conf); err != nil {
+		return nil, fmt.Errorf("invalid config for filter %s: %w", name, err)
+	}
+	return conf, nil
+}
+
+// validateAuthnComposition checks if the Authn plugins can be composed by the AuthnComposer.
+// As the authn outcome is decided before the body arrives, the plugin which authenticates the
+// request body can't be composed.
+func validateAuthnComposition(confs map[string]api.PluginConfig) error {
+	composed := false
+	for _, conf := range confs {
+		if composer, ok := conf.(plugins.AuthnComposer); ok &&
+			composer.AuthnCompositionMode() != plugins.AuthnCompositionModeNone {
+			composed = true
+			break
+		}
+	}
+	if !composed {
+		return nil
+	}
+
+	for name, conf := range confs {
+		if authenticator, ok := conf.(plugins.RequestBodyAuthenticator); ok && authenticator.AuthenticateRequestBody() {
+			return fmt.Errorf("filter %s which authenticates the request body can't be composed with other authn filters", name)
+		}
 	}
 	return nil
 }
@@ -221,11 +252,16 @@ func validateFilterPolicy(policy *FilterPolicy, strict bool) error {
 		}
 	}
 
+	confs := make(map[string]api.PluginConfig, len(policy.Spec.Filters))
 	for name, filter := range policy.Spec.Filters {
-		err := validateFilter(name, filter, strict, targetGateway)
+		conf, err := validateFilter(name, filter, strict, targetGateway)
 		if err != nil {
 			return err
 		}
+		confs[name] = conf
+	}
+	if err := validateAuthnComposition(confs); err != nil {
+		return err
 	}
 
 	names := map[string]struct{}{}
@@ -243,12 +279,20 @@ func validateFilterPolicy(policy *FilterPolicy, strict bool) error {
 
 		names[string(policy.SectionName)] = struct{}{}
 
+		// the filters in SubPolicies are merged with the filters in the policy
+		subConfs := make(map[string]api.PluginConfig, len(confs)+len(policy.Filters))
+		for name, conf := range confs {
+			subConfs[name] = conf
+		}
 		for name, filter := range policy.Filters {
-			err := validateFilter(name, filter, strict, targetGateway)
+			conf, err := validateFilter(name, filter, strict, targetGateway)
 			if err != nil {
 				return err
 			}
-
+			subConfs[name] = conf
+		}
+		if err := validateAuthnComposition(subConfs); err != nil {
+			return err
 		}
 	}
 
@@ -322,6 +366,9 @@ func ValidateConsumer(c *Consumer) error {
 		if err := conf.Validate(); err != nil {
 			return fmt.Errorf("invalid config for filter %s: %w", name, err)
 		}
+		if err := expr.ValidateCelFields(conf); err != nil {
+			return fmt.Errorf("invalid config for filter %s: %w", name, err)
+		}
 	}
 
 	for name, filter := range c.Spec.Filters {
@@ -344,6 +391,18 @@ func ValidateConsumer(c *Consumer) error {
 		if err := conf.Validate(); err != nil {
 			return fmt.Errorf("invalid config for filter %s: %w", name, err)
 		}
+		if err := expr.ValidateCelFields(conf); err != nil {
+			return fmt.Errorf("invalid config for filter %s: %w", name, err)
+		}
+	}
+
+	for _, ns := range c.Spec.ExportTo {
+		if ns == csModel.ExportToAllNamespaces {
+			continue
+		}
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q in exportTo: %s", ns, strings.Join(errs, ", "))
+		}
 	}
 
 	return nil
@@ -417,6 +417,27 @@ func TestValidateFilterPolicy(t *testing.T) {
 			},
 			err: "invalid LocalRateLimit.StatPrefix: value length must be at least 1 runes",
 		},
+		{
+			name: "bad CEL expression",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"celTransform": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"request":{"headers":{"set":[{"name":"x","value":"request.path()"},{"name":"y","value":"request.path() == '/'"}]}}}`),
+							},
+						},
+					},
+				},
+			},
+			err: "invalid config for filter celTransform: invalid request.headers.set[1].value: got bool, wanted string",
+		},
 		{
 			name: "ok, Istio Gateway",
 			policy: &FilterPolicy{
@@ -498,6 +519,90 @@ func TestValidateFilterPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "compose the authn filter which authenticates the request body",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "gateway.networking.k8s.io",
+							Kind:  "HTTPRoute",
+						},
+					},
+					Filters: map[string]Plugin{
+						"multiAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"mode":"ANY_OF"}`),
+							},
+						},
+						"hmacAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"bodyDigest":{}}`),
+							},
+						},
+					},
+				},
+			},
+			err: "filter hmacAuth which authenticates the request body can't be composed with other authn filters",
+		},
+		{
+			name: "compose the authn filter which authenticates the request body, SubPolicies",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"multiAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{}`),
+							},
+						},
+					},
+					SubPolicies: []FilterSubPolicy{
+						{
+							SectionName: sectionName,
+							Filters: map[string]Plugin{
+								"hmacAuth": {
+									Config: runtime.RawExtension{
+										Raw: []byte(`{"bodyDigest":{}}`),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: "filter hmacAuth which authenticates the request body can't be composed with other authn filters",
+		},
+		{
+			name: "compose the authn filter which doesn't authenticate the request body",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "gateway.networking.k8s.io",
+							Kind:  "HTTPRoute",
+						},
+					},
+					Filters: map[string]Plugin{
+						"multiAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"mode":"ALL_OF"}`),
+							},
+						},
+						"hmacAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{}`),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "not implemented, k8s Gateway with Native Plugin",
 			policy: &FilterPolicy{
@@ -805,6 +910,28 @@ func TestValidateConsumer(t *testing.T) {
 			},
 			err: "invalid config for filter opa",
 		},
+		{
+			name: "bad CEL expression",
+			consumer: &Consumer{
+				Spec: ConsumerSpec{
+					Auth: map[string]ConsumerPlugin{
+						"keyAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"key":"cat"}`),
+							},
+						},
+					},
+					Filters: map[string]Plugin{
+						"limitReq": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"average":1,"key":"request.header"}`),
+							},
+						},
+					},
+				},
+			},
+			err: "invalid config for filter limitReq: invalid key: ERROR: <input>:1:8: unexpected failed resolution",
+		},
 		{
 			name: "invalid filter",
 			consumer: &Consumer{
@@ -827,6 +954,37 @@ func TestValidateConsumer(t *testing.T) {
 			},
 			err: "this http filter can not be added by the consumer: keyAuth",
 		},
+		{
+			name: "exportTo",
+			consumer: &Consumer{
+				Spec: ConsumerSpec{
+					Auth: map[string]ConsumerPlugin{
+						"keyAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"key":"cat"}`),
+							},
+						},
+					},
+					ExportTo: []string{"*", "default"},
+				},
+			},
+		},
+		{
+			name: "invalid exportTo",
+			consumer: &Consumer{
+				Spec: ConsumerSpec{
+					Auth: map[string]ConsumerPlugin{
+						"keyAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"key":"cat"}`),
+							},
+						},
+					},
+					ExportTo: []string{"Default"},
+				},
+			},
+			err: `invalid namespace "Default" in exportTo`,
+		},
 		{
 			name: "empty",
 			consumer: &Consumer{
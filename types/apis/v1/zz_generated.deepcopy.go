@@ -133,6 +133,18 @@ func (in *ConsumerSpec) DeepCopyInto(out *ConsumerSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExportTo != nil {
+		in, out := &in.ExportTo, &out.ExportTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerSpec.
@@ -195,6 +207,22 @@ func (in *DynamicConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicConfigDataPlaneStatus) DeepCopyInto(out *DynamicConfigDataPlaneStatus) {
+	*out = *in
+	in.LastReportedAt.DeepCopyInto(&out.LastReportedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicConfigDataPlaneStatus.
+func (in *DynamicConfigDataPlaneStatus) DeepCopy() *DynamicConfigDataPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicConfigDataPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicConfigList) DeepCopyInto(out *DynamicConfigList) {
 	*out = *in
@@ -227,6 +255,27 @@ func (in *DynamicConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicConfigRevision) DeepCopyInto(out *DynamicConfigRevision) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicConfigRevision.
+func (in *DynamicConfigRevision) DeepCopy() *DynamicConfigRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicConfigRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicConfigSpec) DeepCopyInto(out *DynamicConfigSpec) {
 	*out = *in
@@ -253,6 +302,20 @@ func (in *DynamicConfigStatus) DeepCopyInto(out *DynamicConfigStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]DynamicConfigRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataPlanes != nil {
+		in, out := &in.DataPlanes, &out.DataPlanes
+		*out = make([]DynamicConfigDataPlaneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ChangeDetector = in.ChangeDetector
 }
 
@@ -15,12 +15,17 @@
 package expr
 
 import (
+	"container/list"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
@@ -38,10 +43,19 @@ var (
 
 	celEnv     *cel.Env
 	initCelEnv = sync.OnceFunc(func() {
+		loadLimits()
+
 		options := []cel.EnvOption{
 			cel.CustomTypeAdapter(&customTypeAdapter{}),
 			defineRequest(),
+			defineResponse(),
+			defineUpstream(),
 			defineSource(),
+			defineConsumer(),
+			defineRoute(),
+			definePluginState(),
+			defineTime(),
+			defineHelpers(),
 		}
 
 		var err error
@@ -52,10 +66,85 @@ var (
 			panic(err)
 		}
 	})
+
+	// expression & return type => the compiled result
+	scriptCache = newScriptLRU(maxCachedScripts)
 )
 
+// maxCachedScripts bounds the memory used by the cache when the configuration changes frequently
+const maxCachedScripts = 4096
+
+type scriptCacheKey struct {
+	expr       string
+	returnType string
+	// the limits are part of the compiled program
+	costLimit     uint64
+	interruptible bool
+}
+
+type scriptCacheEntry struct {
+	key    scriptCacheKey
+	script Script
+	err    error
+}
+
+// scriptLRU evicts the least recently used script when it is full, so the scripts in the current
+// configuration stay cached after the old ones fill the cache.
+type scriptLRU struct {
+	lock     sync.Mutex
+	capacity int
+	entries  map[scriptCacheKey]*list.Element
+	order    *list.List // the front is the most recently used
+}
+
+func newScriptLRU(capacity int) *scriptLRU {
+	return &scriptLRU{
+		capacity: capacity,
+		entries:  make(map[scriptCacheKey]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+func (c *scriptLRU) Get(key scriptCacheKey) (*scriptCacheEntry, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*scriptCacheEntry), true
+}
+
+func (c *scriptLRU) Add(entry *scriptCacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[entry.key]; ok {
+		// compiled concurrently, keep the first one
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*scriptCacheEntry).key)
+	}
+}
+
+func (c *scriptLRU) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.order.Len()
+}
+
 type CelScript struct {
 	program cel.Program
+
+	needRequestBody bool
 }
 
 func compile(env *cel.Env, expr string, celType *cel.Type) (*cel.Ast, error) {
@@ -67,54 +156,167 @@ func compile(env *cel.Env, expr string, celType *cel.Type) (*cel.Ast, error) {
 	if ast.OutputType() != celType {
 		return nil, fmt.Errorf("got %v, wanted %v", ast.OutputType(), celType)
 	}
+
+	if costLimit > 0 {
+		// Reject the expression which is always too expensive. The maximum estimated cost assumes
+		// the data from the request is as large as possible, so a cheap expression like checking
+		// an element in the request body will exceed the limit. Such expressions are stopped by
+		// the cost limit at runtime instead.
+		est, err := env.EstimateCost(ast, costEstimator{})
+		if err != nil {
+			return nil, err
+		}
+		if est.Min > costLimit {
+			return nil, fmt.Errorf("estimated cost %d exceeds the limit %d", est.Min, costLimit)
+		}
+	}
 	return ast, nil
 }
 
+// CompileCel compiles the expression which returns the given type. The compiled result is cached,
+// so compiling the same expression again is cheap.
 func CompileCel(expr string, returnType *cel.Type) (Script, error) {
 	initCelEnv()
+
+	key := scriptCacheKey{
+		expr:          expr,
+		returnType:    returnType.String(),
+		costLimit:     costLimit,
+		interruptible: evalTimeout > 0,
+	}
+	if entry, ok := scriptCache.Get(key); ok {
+		return entry.script, entry.err
+	}
+
+	s, err := compileCel(expr, returnType)
+	scriptCache.Add(&scriptCacheEntry{key: key, script: s, err: err})
+	return s, err
+}
+
+func compileCel(expr string, returnType *cel.Type) (Script, error) {
 	ast, err := compile(celEnv, expr, returnType)
 	if err != nil {
 		return nil, err
 	}
-	program, _ := celEnv.Program(ast)
+
+	opts := []cel.ProgramOption{}
+	if costLimit > 0 {
+		opts = append(opts, cel.CostLimit(costLimit))
+	}
+	if evalTimeout > 0 {
+		opts = append(opts, cel.InterruptCheckFrequency(interruptCheckFrequency))
+	}
+	program, err := celEnv.Program(ast, opts...)
+	if err != nil {
+		return nil, err
+	}
 
 	s := &CelScript{
-		program: program,
+		program:         program,
+		needRequestBody: referenceAny(ast, "request_body", "request_json_body"),
 	}
 	return s, nil
 }
 
+// referenceAny checks if the expression calls any of the given overloads
+func referenceAny(ast *cel.Ast, overloads ...string) bool {
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return false
+	}
+	for _, ref := range checked.GetReferenceMap() {
+		for _, id := range ref.GetOverloadId() {
+			for _, overload := range overloads {
+				if id == overload {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+type celVars struct {
+	request  *request
+	response *response
+	upstream *upstream
+	source   *source
+	consumer *consumer
+	route    *route
+	state    *pluginState
+	time     *timeVar
+
+	activation map[string]any
+}
+
 var varsPool = sync.Pool{
 	New: func() any {
-		return map[string]any{
-			"request": &request{},
-			"source":  &source{},
+		v := &celVars{
+			request:  &request{},
+			response: &response{},
+			upstream: &upstream{},
+			source:   &source{},
+			consumer: &consumer{},
+			route:    &route{},
+			state:    &pluginState{},
+			time:     &timeVar{},
+		}
+		v.activation = map[string]any{
+			"request":      v.request,
+			"response":     v.response,
+			"upstream":     v.upstream,
+			"source":       v.source,
+			"consumer":     v.consumer,
+			"route":        v.route,
+			"plugin_state": v.state,
+			"time":         v.time,
 		}
+		return v
 	},
 }
 
-func (s *CelScript) EvalWithRequest(cb api.FilterCallbackHandler, headers api.RequestHeaderMap) (any, error) {
+func (s *CelScript) eval(cb api.FilterCallbackHandler, reqHeaders api.RequestHeaderMap, reqBody api.BufferInstance,
+	respHeaders api.ResponseHeaderMap, onLog bool) (any, error) {
+
 	data := varsPool.Get()
-	vars, ok := data.(map[string]any)
+	vars, ok := data.(*celVars)
 	if !ok {
 		return nil, fmt.Errorf("unexpected type: %s", reflect.TypeOf(data))
 	}
-	r, ok := vars["request"].(*request)
-	if !ok {
-		return nil, fmt.Errorf("unexpected request type: %s", reflect.TypeOf(vars["request"]))
-	}
-	r.headers = headers
-	r.callback = cb
-	so, ok := vars["source"].(*source)
-	if !ok {
-		return nil, fmt.Errorf("unexpected source type: %s", reflect.TypeOf(vars["source"]))
+	vars.request.headers = reqHeaders
+	vars.request.body = reqBody
+	vars.request.callback = cb
+	vars.response.headers = respHeaders
+	vars.response.callback = cb
+	vars.response.onLog = onLog
+	vars.upstream.callback = cb
+	vars.source.callback = cb
+	vars.consumer.callback = cb
+	vars.route.callback = cb
+	vars.state.callback = cb
+
+	var res ref.Val
+	var err error
+	if evalTimeout > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), evalTimeout)
+		res, _, err = s.program.ContextEval(ctx, vars.activation)
+		cancel()
+	} else {
+		res, _, err = s.program.Eval(vars.activation)
 	}
-	so.callback = cb
 
-	res, _, err := s.program.Eval(vars)
-	r.headers = nil
-	r.callback = nil
-	so.callback = nil
+	vars.request.headers = nil
+	vars.request.body = nil
+	vars.request.parsedBody = nil
+	vars.request.callback = nil
+	vars.response.headers = nil
+	vars.response.callback = nil
+	vars.response.onLog = false
+	vars.upstream.callback = nil
+	vars.source.callback = nil
+	vars.consumer.callback = nil
+	vars.route.callback = nil
+	vars.state.callback = nil
 	varsPool.Put(vars)
 
 	if err != nil {
@@ -124,10 +326,40 @@ func (s *CelScript) EvalWithRequest(cb api.FilterCallbackHandler, headers api.Re
 	return res.Value(), nil
 }
 
+func (s *CelScript) EvalWithRequest(cb api.FilterCallbackHandler, headers api.RequestHeaderMap) (any, error) {
+	return s.eval(cb, headers, nil, nil, false)
+}
+
+func (s *CelScript) EvalWithRequestBody(cb api.FilterCallbackHandler, headers api.RequestHeaderMap, body api.BufferInstance) (any, error) {
+	return s.eval(cb, headers, body, nil, false)
+}
+
+func (s *CelScript) EvalWithResponse(cb api.FilterCallbackHandler, reqHeaders api.RequestHeaderMap,
+	respHeaders api.ResponseHeaderMap) (any, error) {
+
+	return s.eval(cb, reqHeaders, nil, respHeaders, false)
+}
+
+// EvalOnLog evaluates the script in the OnLog phase. The response headers can be nil if the
+// stream is terminated before the response is sent. In this case, the response status comes from
+// the stream info.
+func (s *CelScript) EvalOnLog(cb api.FilterCallbackHandler, reqHeaders api.RequestHeaderMap,
+	respHeaders api.ResponseHeaderMap) (any, error) {
+
+	return s.eval(cb, reqHeaders, nil, respHeaders, true)
+}
+
+func (s *CelScript) NeedRequestBody() bool {
+	return s.needRequestBody
+}
+
 type request struct {
 	customType
 	headers  api.RequestHeaderMap
+	body     api.BufferInstance
 	callback api.FilterCallbackHandler
+
+	parsedBody ref.Val
 }
 
 var requestType = cel.ObjectType("htnn.request", traits.ReceiverType)
@@ -190,6 +422,21 @@ func defineRequest() cel.EnvOption {
 			parameterTypes: []*exprpb.Type{},
 			returnType:     decls.String,
 		},
+		{
+			method:         "elapsed",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Duration,
+		},
+		{
+			method:         "body",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+		{
+			method:         "json_body",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Dyn,
+		},
 	} {
 		declarations = append(declarations,
 			decls.NewFunction(dec.method,
@@ -210,6 +457,24 @@ func fromProperty(cb api.FilterCallbackHandler, property string) ref.Val {
 }
 
 func (r *request) Receive(function string, overload string, args []ref.Val) ref.Val {
+	switch function {
+	case "id":
+		return fromProperty(r.callback, "request.id")
+	case "body":
+		if r.body == nil {
+			return types.String("")
+		}
+		return types.String(r.body.String())
+	case "json_body":
+		return r.JSONBody()
+	case "elapsed":
+		return r.Elapsed()
+	}
+
+	if r.headers == nil {
+		return types.NewErr("request headers are not available")
+	}
+
 	switch function {
 	case "path":
 		return types.String(r.headers.Path())
@@ -236,8 +501,6 @@ func (r *request) Receive(function string, overload string, args []ref.Val) ref.
 			return types.NewErr("unexpected type: %s", reflect.TypeOf(args[0].Value()))
 		}
 		return types.String(r.Query(name))
-	case "id":
-		return fromProperty(r.callback, "request.id")
 	}
 
 	return types.NewErr("no such function - %s", function)
@@ -270,10 +533,191 @@ func (r *request) Query(name string) string {
 	return strings.Join(v, ",")
 }
 
+// JSONBody returns the request body parsed as JSON. The body is parsed once per evaluation.
+// An empty body is treated as an empty object.
+func (r *request) JSONBody() ref.Val {
+	if r.parsedBody != nil {
+		return r.parsedBody
+	}
+
+	var data []byte
+	if r.body != nil {
+		data = r.body.Bytes()
+	}
+	if len(data) == 0 {
+		r.parsedBody = types.DefaultTypeAdapter.NativeToValue(map[string]any{})
+		return r.parsedBody
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return types.NewErr("invalid JSON body: %s", err)
+	}
+	r.parsedBody = types.DefaultTypeAdapter.NativeToValue(v)
+	return r.parsedBody
+}
+
+// Elapsed returns the time elapsed since the first byte of the request is received.
+// We don't name it duration as it conflicts with the CEL builtin function.
+func (r *request) Elapsed() ref.Val {
+	s, err := r.callback.GetProperty("request.time")
+	if err != nil {
+		return types.NewErr("failed to get request time: %s", err)
+	}
+	start, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return types.NewErr("invalid request time %s: %s", s, err)
+	}
+	return types.Duration{Duration: time.Since(start)}
+}
+
 func (r *request) TypeName() string {
 	return requestType.TypeName()
 }
 
+type response struct {
+	customType
+	headers  api.ResponseHeaderMap
+	callback api.FilterCallbackHandler
+	onLog    bool
+}
+
+var responseType = cel.ObjectType("htnn.response", traits.ReceiverType)
+var responseExprType = decls.NewObjectType("htnn.response")
+
+func defineResponse() cel.EnvOption {
+	cls := "response"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, responseExprType, nil),
+	}
+
+	for _, dec := range []struct {
+		method         string
+		parameterTypes []*exprpb.Type
+		returnType     *exprpb.Type
+	}{
+		{
+			method:         "status",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Int,
+		},
+		{
+			method:         "header",
+			parameterTypes: []*exprpb.Type{decls.String},
+			returnType:     decls.String,
+		},
+	} {
+		declarations = append(declarations,
+			decls.NewFunction(dec.method,
+				decls.NewInstanceOverload(fmt.Sprintf("%s_%s", cls, dec.method),
+					append([]*exprpb.Type{responseExprType}, dec.parameterTypes...), dec.returnType)),
+		)
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (r *response) Receive(function string, overload string, args []ref.Val) ref.Val {
+	if r.headers == nil {
+		if !r.onLog {
+			return types.NewErr("response is only available when processing the response")
+		}
+
+		// no response is sent to the client
+		switch function {
+		case "status":
+			code, _ := r.callback.StreamInfo().ResponseCode()
+			return types.Int(code)
+		case "header":
+			return types.String("")
+		}
+	}
+
+	switch function {
+	case "status":
+		code, _ := r.headers.Status()
+		return types.Int(code)
+	case "header":
+		name, ok := args[0].Value().(string)
+		if !ok {
+			return types.NewErr("unexpected type: %s", reflect.TypeOf(args[0].Value()))
+		}
+		v := r.headers.Values(name)
+		return types.String(strings.Join(v, ","))
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (r *response) TypeName() string {
+	return responseType.TypeName()
+}
+
+type upstream struct {
+	customType
+	callback api.FilterCallbackHandler
+}
+
+var upstreamType = cel.ObjectType("htnn.upstream", traits.ReceiverType)
+var upstreamExprType = decls.NewObjectType("htnn.upstream")
+
+func defineUpstream() cel.EnvOption {
+	cls := "upstream"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, upstreamExprType, nil),
+	}
+
+	for _, dec := range []struct {
+		method         string
+		parameterTypes []*exprpb.Type
+		returnType     *exprpb.Type
+	}{
+		{
+			method:         "address",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+		{
+			method:         "local_address",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+		{
+			method:         "cluster",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+	} {
+		declarations = append(declarations,
+			decls.NewFunction(dec.method,
+				decls.NewInstanceOverload(fmt.Sprintf("%s_%s", cls, dec.method),
+					append([]*exprpb.Type{upstreamExprType}, dec.parameterTypes...), dec.returnType)),
+		)
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (u *upstream) Receive(function string, overload string, args []ref.Val) ref.Val {
+	// The upstream information is empty if the request is not sent to the upstream
+	info := u.callback.StreamInfo()
+	switch function {
+	case "address":
+		addr, _ := info.UpstreamRemoteAddress()
+		return types.String(addr)
+	case "local_address":
+		addr, _ := info.UpstreamLocalAddress()
+		return types.String(addr)
+	case "cluster":
+		name, _ := info.UpstreamClusterName()
+		return types.String(name)
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (u *upstream) TypeName() string {
+	return upstreamType.TypeName()
+}
+
 type source struct {
 	customType
 	callback api.FilterCallbackHandler
@@ -341,6 +785,313 @@ func (s *source) TypeName() string {
 	return sourceType.TypeName()
 }
 
+type consumer struct {
+	customType
+	callback api.FilterCallbackHandler
+}
+
+var consumerType = cel.ObjectType("htnn.consumer", traits.ReceiverType)
+var consumerExprType = decls.NewObjectType("htnn.consumer")
+
+func defineConsumer() cel.EnvOption {
+	cls := "consumer"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, consumerExprType, nil),
+	}
+
+	for _, dec := range []struct {
+		method         string
+		parameterTypes []*exprpb.Type
+		returnType     *exprpb.Type
+	}{
+		{
+			method:         "name",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+		{
+			method:         "label",
+			parameterTypes: []*exprpb.Type{decls.String},
+			returnType:     decls.String,
+		},
+		{
+			method:         "labels",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.NewMapType(decls.String, decls.String),
+		},
+	} {
+		declarations = append(declarations,
+			decls.NewFunction(dec.method,
+				decls.NewInstanceOverload(fmt.Sprintf("%s_%s", cls, dec.method),
+					append([]*exprpb.Type{consumerExprType}, dec.parameterTypes...), dec.returnType)),
+		)
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (c *consumer) Receive(function string, overload string, args []ref.Val) ref.Val {
+	// The consumer is only available after the authentication. An unauthenticated request is
+	// treated as a consumer without name and metadata.
+	var consumer api.Consumer
+	if c.callback != nil {
+		consumer = c.callback.GetConsumer()
+	}
+
+	switch function {
+	case "name":
+		if consumer == nil {
+			return types.String("")
+		}
+		return types.String(consumer.Name())
+	case "label":
+		name, ok := args[0].Value().(string)
+		if !ok {
+			return types.NewErr("unexpected type: %s", reflect.TypeOf(args[0].Value()))
+		}
+		if consumer == nil {
+			return types.String("")
+		}
+		return types.String(consumer.Metadata()[name])
+	case "labels":
+		labels := map[string]string{}
+		if consumer != nil && consumer.Metadata() != nil {
+			labels = consumer.Metadata()
+		}
+		return types.DefaultTypeAdapter.NativeToValue(labels)
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (c *consumer) TypeName() string {
+	return consumerType.TypeName()
+}
+
+type route struct {
+	customType
+	callback api.FilterCallbackHandler
+}
+
+var routeType = cel.ObjectType("htnn.route", traits.ReceiverType)
+var routeExprType = decls.NewObjectType("htnn.route")
+
+func defineRoute() cel.EnvOption {
+	cls := "route"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, routeExprType, nil),
+	}
+
+	for _, dec := range []struct {
+		method         string
+		parameterTypes []*exprpb.Type
+		returnType     *exprpb.Type
+	}{
+		{
+			method:         "name",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+		{
+			method:         "cluster",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.String,
+		},
+	} {
+		declarations = append(declarations,
+			decls.NewFunction(dec.method,
+				decls.NewInstanceOverload(fmt.Sprintf("%s_%s", cls, dec.method),
+					append([]*exprpb.Type{routeExprType}, dec.parameterTypes...), dec.returnType)),
+		)
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (r *route) Receive(function string, overload string, args []ref.Val) ref.Val {
+	switch function {
+	case "name":
+		return fromProperty(r.callback, "xds.route_name")
+	case "cluster":
+		return fromProperty(r.callback, "xds.cluster_name")
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (r *route) TypeName() string {
+	return routeType.TypeName()
+}
+
+type pluginState struct {
+	customType
+	callback api.FilterCallbackHandler
+}
+
+var pluginStateType = cel.ObjectType("htnn.plugin_state", traits.ReceiverType)
+var pluginStateExprType = decls.NewObjectType("htnn.plugin_state")
+
+func definePluginState() cel.EnvOption {
+	cls := "plugin_state"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, pluginStateExprType, nil),
+		decls.NewFunction("get",
+			decls.NewInstanceOverload(fmt.Sprintf("%s_get", cls),
+				[]*exprpb.Type{pluginStateExprType, decls.String, decls.String}, decls.String)),
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (s *pluginState) Receive(function string, overload string, args []ref.Val) ref.Val {
+	switch function {
+	case "get":
+		plugin, ok := args[0].Value().(string)
+		if !ok {
+			return types.NewErr("unexpected type: %s", reflect.TypeOf(args[0].Value()))
+		}
+		key, ok := args[1].Value().(string)
+		if !ok {
+			return types.NewErr("unexpected type: %s", reflect.TypeOf(args[1].Value()))
+		}
+		v := s.callback.PluginState().Get(plugin, key)
+		switch v := v.(type) {
+		case nil:
+			return types.String("")
+		case string:
+			return types.String(v)
+		default:
+			return types.String(fmt.Sprint(v))
+		}
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (s *pluginState) TypeName() string {
+	return pluginStateType.TypeName()
+}
+
+type timeVar struct {
+	customType
+}
+
+var timeType = cel.ObjectType("htnn.time", traits.ReceiverType)
+var timeExprType = decls.NewObjectType("htnn.time")
+
+func defineTime() cel.EnvOption {
+	cls := "time"
+	declarations := []*exprpb.Decl{
+		decls.NewConst(cls, timeExprType, nil),
+	}
+
+	for _, dec := range []struct {
+		method         string
+		parameterTypes []*exprpb.Type
+		returnType     *exprpb.Type
+	}{
+		{
+			method:         "now",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Timestamp,
+		},
+		{
+			method:         "hour_of_day",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Int,
+		},
+		{
+			method:         "weekday",
+			parameterTypes: []*exprpb.Type{},
+			returnType:     decls.Int,
+		},
+	} {
+		declarations = append(declarations,
+			decls.NewFunction(dec.method,
+				decls.NewInstanceOverload(fmt.Sprintf("%s_%s", cls, dec.method),
+					append([]*exprpb.Type{timeExprType}, dec.parameterTypes...), dec.returnType)),
+		)
+	}
+	return cel.Declarations(declarations...)
+}
+
+func (t *timeVar) Receive(function string, overload string, args []ref.Val) ref.Val {
+	// Use the local time of the data plane
+	now := time.Now()
+	switch function {
+	case "now":
+		return types.Timestamp{Time: now}
+	case "hour_of_day":
+		return types.Int(now.Hour())
+	case "weekday":
+		// Sunday is 0
+		return types.Int(now.Weekday())
+	}
+
+	return types.NewErr("no such function - %s", function)
+}
+
+func (t *timeVar) TypeName() string {
+	return timeType.TypeName()
+}
+
+func defineHelpers() cel.EnvOption {
+	return cel.Lib(helpersLib{})
+}
+
+type helpersLib struct{}
+
+func (helpersLib) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("ip_in_cidr",
+			cel.Overload("ip_in_cidr_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(ipInCIDR))),
+		cel.Function("glob_match",
+			cel.Overload("glob_match_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(globMatch))),
+	}
+}
+
+func (helpersLib) ProgramOptions() []cel.ProgramOption {
+	return []cel.ProgramOption{}
+}
+
+func ipInCIDR(lhs ref.Val, rhs ref.Val) ref.Val {
+	s, ok := lhs.Value().(string)
+	if !ok {
+		return types.NewErr("unexpected type: %s", reflect.TypeOf(lhs.Value()))
+	}
+	cidr, ok := rhs.Value().(string)
+	if !ok {
+		return types.NewErr("unexpected type: %s", reflect.TypeOf(rhs.Value()))
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return types.NewErr("invalid CIDR %s: %s", cidr, err)
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		// the IP may come from the header, so we don't treat it as an error
+		return types.False
+	}
+	return types.Bool(ipNet.Contains(ip))
+}
+
+func globMatch(lhs ref.Val, rhs ref.Val) ref.Val {
+	pattern, ok := lhs.Value().(string)
+	if !ok {
+		return types.NewErr("unexpected type: %s", reflect.TypeOf(lhs.Value()))
+	}
+	s, ok := rhs.Value().(string)
+	if !ok {
+		return types.NewErr("unexpected type: %s", reflect.TypeOf(rhs.Value()))
+	}
+
+	matched, err := path.Match(pattern, s)
+	if err != nil {
+		return types.NewErr("invalid glob pattern %s: %s", pattern, err)
+	}
+	return types.Bool(matched)
+}
+
 type customType struct {
 }
 
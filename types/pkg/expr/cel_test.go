@@ -18,12 +18,14 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/stretchr/testify/require"
 
+	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 )
 
@@ -257,3 +259,457 @@ func TestCelWithSource(t *testing.T) {
 		})
 	}
 }
+
+type testConsumer struct {
+	name     string
+	metadata map[string]string
+}
+
+func (c *testConsumer) Name() string {
+	return c.name
+}
+
+func (c *testConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
+func (c *testConsumer) Metadata() map[string]string {
+	return c.metadata
+}
+
+func TestCelWithConsumer(t *testing.T) {
+	c := &testConsumer{
+		name: "leo",
+		metadata: map[string]string{
+			"tier": "gold",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		consumer api.Consumer
+		expect   func(t *testing.T, res any)
+	}{
+		{
+			name:     "name",
+			code:     `consumer.name()`,
+			consumer: c,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "leo", res)
+			},
+		},
+		{
+			name:     "label",
+			code:     `consumer.label("tier")`,
+			consumer: c,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "gold", res)
+			},
+		},
+		{
+			name:     "label not found",
+			code:     `consumer.label("tenant")`,
+			consumer: c,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "", res)
+			},
+		},
+		{
+			name: "name without consumer",
+			code: `consumer.name()`,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "", res)
+			},
+		},
+		{
+			name: "label without consumer",
+			code: `consumer.label("tier")`,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "", res)
+			},
+		},
+		{
+			name:     "labels",
+			code:     `consumer.labels()["tier"] + string(size(consumer.labels()))`,
+			consumer: c,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "gold1", res)
+			},
+		},
+		{
+			name: "labels without consumer",
+			code: `string(size(consumer.labels()))`,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "0", res)
+			},
+		},
+		{
+			name:     "combine with request",
+			code:     `consumer.label("tier") + ":" + source.ip()`,
+			consumer: c,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "gold:183.128.130.43", res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := CompileCel(tt.code, cel.StringType)
+			require.NoError(t, err)
+			cb := envoy.NewFilterCallbackHandler()
+			if tt.consumer != nil {
+				cb.SetConsumer(tt.consumer)
+			}
+			res, err := s.EvalWithRequest(cb, nil)
+			require.NoError(t, err)
+			tt.expect(t, res)
+		})
+	}
+}
+
+func TestCelWithRequestBody(t *testing.T) {
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	tests := []struct {
+		name   string
+		code   string
+		body   []byte
+		err    string
+		expect any
+	}{
+		{
+			name:   "body",
+			code:   `request.body()`,
+			body:   []byte("hello"),
+			expect: "hello",
+		},
+		{
+			name:   "json body",
+			code:   `request.json_body().user.name + ":" + string(request.json_body().ids[1])`,
+			body:   []byte(`{"user":{"name":"leo"},"ids":[1,2]}`),
+			expect: "leo:2",
+		},
+		{
+			name:   "empty json body",
+			code:   `string(size(request.json_body()))`,
+			expect: "0",
+		},
+		{
+			name: "invalid json body",
+			code: `string(request.json_body())`,
+			body: []byte(`{`),
+			err:  "invalid JSON body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := CompileCel(tt.code, cel.StringType)
+			require.NoError(t, err)
+			require.True(t, s.NeedRequestBody())
+			var body api.BufferInstance
+			if tt.body != nil {
+				body = envoy.NewBufferInstance(tt.body)
+			}
+			res, err := s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, body)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, res)
+		})
+	}
+
+	s, err := CompileCel(`request.header("x")`, cel.StringType)
+	require.NoError(t, err)
+	require.False(t, s.NeedRequestBody())
+}
+
+func TestCelWithResponse(t *testing.T) {
+	reqHdr := envoy.NewRequestHeaderMap(http.Header{":path": []string{"/x"}})
+	respHdr := envoy.NewResponseHeaderMap(http.Header{":status": []string{"503"}, "Server": []string{"a"}})
+
+	s, err := CompileCel(`request.path() + ":" + string(response.status()) + ":" + response.header("server")`, cel.StringType)
+	require.NoError(t, err)
+	res, err := s.EvalWithResponse(envoy.NewFilterCallbackHandler(), reqHdr, respHdr)
+	require.NoError(t, err)
+	require.Equal(t, "/x:503:a", res)
+
+	_, err = s.EvalWithRequest(envoy.NewFilterCallbackHandler(), reqHdr)
+	require.ErrorContains(t, err, "response is only available when processing the response")
+
+	s, err = CompileCel(`response.status() >= 500`, cel.BoolType)
+	require.NoError(t, err)
+	res, err = s.EvalWithResponse(envoy.NewFilterCallbackHandler(), nil, respHdr)
+	require.NoError(t, err)
+	require.Equal(t, true, res)
+}
+
+func TestCelWithRoute(t *testing.T) {
+	s, err := CompileCel(`route.name() + ":" + route.cluster()`, cel.StringType)
+	require.NoError(t, err)
+	cb := envoy.NewFilterCallbackHandler()
+	patches := gomonkey.ApplyMethodFunc(cb, "GetProperty", func(s string) (string, error) {
+		return "property." + s, nil
+	})
+	defer patches.Reset()
+	res, err := s.EvalWithRequest(cb, nil)
+	require.NoError(t, err)
+	require.Equal(t, "property.xds.route_name:property.xds.cluster_name", res)
+}
+
+func TestCelWithTime(t *testing.T) {
+	// 2024-05-04 is Saturday
+	now := time.Date(2024, 5, 4, 13, 30, 0, 0, time.Local)
+	patches := gomonkey.ApplyFuncReturn(time.Now, now)
+	defer patches.Reset()
+
+	s, err := CompileCel(`string(time.hour_of_day()) + ":" + string(time.weekday()) + ":" + string(time.now().getMinutes())`,
+		cel.StringType)
+	require.NoError(t, err)
+	res, err := s.EvalWithRequest(envoy.NewFilterCallbackHandler(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "13:6:30", res)
+
+	s, err = CompileCel(`time.now() > timestamp("2024-01-01T00:00:00Z")`, cel.BoolType)
+	require.NoError(t, err)
+	res, err = s.EvalWithRequest(envoy.NewFilterCallbackHandler(), nil)
+	require.NoError(t, err)
+	require.Equal(t, true, res)
+}
+
+func TestCelHelpers(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		err    string
+		expect any
+	}{
+		{
+			name:   "ip in cidr",
+			code:   `ip_in_cidr(source.ip(), "183.128.0.0/16")`,
+			expect: true,
+		},
+		{
+			name:   "ip not in cidr",
+			code:   `ip_in_cidr(source.ip(), "10.0.0.0/8")`,
+			expect: false,
+		},
+		{
+			name:   "ipv6",
+			code:   `ip_in_cidr("::1", "::1/128")`,
+			expect: true,
+		},
+		{
+			name:   "invalid ip",
+			code:   `ip_in_cidr("localhost", "10.0.0.0/8")`,
+			expect: false,
+		},
+		{
+			name: "invalid cidr",
+			code: `ip_in_cidr(source.ip(), "10.0.0.0")`,
+			err:  "invalid CIDR 10.0.0.0",
+		},
+		{
+			name:   "glob",
+			code:   `glob_match("*.example.com", "api.example.com")`,
+			expect: true,
+		},
+		{
+			name:   "glob not match",
+			code:   `glob_match("/api/*", "/api/v1/users")`,
+			expect: false,
+		},
+		{
+			name: "invalid glob",
+			code: `glob_match("[", "a")`,
+			err:  "invalid glob pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := CompileCel(tt.code, cel.BoolType)
+			require.NoError(t, err)
+			res, err := s.EvalWithRequest(envoy.NewFilterCallbackHandler(), nil)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, res)
+		})
+	}
+}
+
+func TestCelOnLog(t *testing.T) {
+	cb := envoy.NewFilterCallbackHandler()
+	info := &envoy.StreamInfo{}
+	cb.SetStreamInfo(info)
+	patches := gomonkey.ApplyMethodReturn(info, "UpstreamRemoteAddress", "10.0.0.2:8080", true)
+	patches.ApplyMethodReturn(info, "UpstreamLocalAddress", "10.0.0.1:36789", true)
+	patches.ApplyMethodReturn(info, "UpstreamClusterName", "backend", true)
+	patches.ApplyMethodReturn(info, "ResponseCode", uint32(504), true)
+	start := time.Now().Add(-2 * time.Second).Format(time.RFC3339Nano)
+	patches.ApplyMethodFunc(cb, "GetProperty", func(s string) (string, error) {
+		return start, nil
+	})
+	defer patches.Reset()
+
+	s, err := CompileCel(`upstream.address() + "," + upstream.local_address() + "," + upstream.cluster()`, cel.StringType)
+	require.NoError(t, err)
+	res, err := s.EvalOnLog(cb, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2:8080,10.0.0.1:36789,backend", res)
+
+	s, err = CompileCel(`request.elapsed() > duration("1s") && request.elapsed() < duration("1m")`, cel.BoolType)
+	require.NoError(t, err)
+	res, err = s.EvalOnLog(cb, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, true, res)
+
+	// use the response code in the stream info when no response headers
+	s, err = CompileCel(`string(response.status()) + response.header("server")`, cel.StringType)
+	require.NoError(t, err)
+	res, err = s.EvalOnLog(cb, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "504", res)
+
+	respHdr := envoy.NewResponseHeaderMap(http.Header{":status": []string{"502"}, "Server": []string{"a"}})
+	res, err = s.EvalOnLog(cb, nil, respHdr)
+	require.NoError(t, err)
+	require.Equal(t, "502a", res)
+}
+
+func TestCelWithPluginState(t *testing.T) {
+	cb := envoy.NewFilterCallbackHandler()
+	cb.PluginState().Set("auth", "user", "leo")
+	cb.PluginState().Set("auth", "level", 3)
+
+	s, err := CompileCel(`plugin_state.get("auth", "user") + ":" + plugin_state.get("auth", "level") + ":" + plugin_state.get("auth", "x")`,
+		cel.StringType)
+	require.NoError(t, err)
+	res, err := s.EvalWithRequest(cb, nil)
+	require.NoError(t, err)
+	require.Equal(t, "leo:3:", res)
+}
+
+func TestCompileCelCache(t *testing.T) {
+	s1, err := CompileCel(`request.path() == "/cached"`, cel.BoolType)
+	require.NoError(t, err)
+	s2, err := CompileCel(`request.path() == "/cached"`, cel.BoolType)
+	require.NoError(t, err)
+	require.Same(t, s1, s2)
+
+	// the return type is part of the key
+	_, err = CompileCel(`request.path() == "/cached"`, cel.StringType)
+	require.Error(t, err)
+}
+
+func TestScriptLRU(t *testing.T) {
+	c := newScriptLRU(2)
+	key := func(expr string) scriptCacheKey {
+		return scriptCacheKey{expr: expr, returnType: "bool"}
+	}
+	c.Add(&scriptCacheEntry{key: key("a")})
+	c.Add(&scriptCacheEntry{key: key("b")})
+	_, ok := c.Get(key("a"))
+	require.True(t, ok)
+
+	// the least recently used one is evicted
+	c.Add(&scriptCacheEntry{key: key("c")})
+	require.Equal(t, 2, c.Len())
+	_, ok = c.Get(key("b"))
+	require.False(t, ok)
+	_, ok = c.Get(key("a"))
+	require.True(t, ok)
+	_, ok = c.Get(key("c"))
+	require.True(t, ok)
+}
+
+func TestCelCostLimit(t *testing.T) {
+	initCelEnv()
+	origCostLimit := costLimit
+	origEvalTimeout := evalTimeout
+	defer func() {
+		costLimit = origCostLimit
+		evalTimeout = origEvalTimeout
+	}()
+
+	costLimit = 1000
+	_, err := CompileCel(`[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(x, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(y,
+		[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(z, x + y + z))).size() > 0`, cel.BoolType)
+	require.ErrorContains(t, err, "exceeds the limit")
+
+	ids := make([]byte, 0, 1024)
+	ids = append(ids, `{"roles":["admin"],"ids":[1`...)
+	for i := 0; i < 200; i++ {
+		ids = append(ids, ",1"...)
+	}
+	ids = append(ids, "]}"...)
+	hdr := envoy.NewRequestHeaderMap(http.Header{"X-Id": []string{"1"}})
+
+	// the expressions which iterate the request data are only stopped at runtime, as the size of the
+	// data is unknown when compiling
+	for _, expr := range []string{
+		`"admin" in request.json_body().roles`,
+		`request.json_body().ids.exists(x, x == 1)`,
+	} {
+		s, err := CompileCel(expr, cel.BoolType)
+		require.NoError(t, err, expr)
+		res, err := s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, envoy.NewBufferInstance(ids))
+		require.NoError(t, err, expr)
+		require.Equal(t, true, res, expr)
+	}
+	s, err := CompileCel(`request.json_body().ids.all(x, x > 0 && request.json_body().ids.exists(y, y == x))`, cel.BoolType)
+	require.NoError(t, err)
+	_, err = s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, envoy.NewBufferInstance(ids))
+	require.ErrorContains(t, err, "actual cost limit exceeded")
+
+	// the cached result is not reused when the limit changes
+	costLimit = DefaultCostLimit
+	_, err = CompileCel(`[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(x, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(y,
+		[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(z, x + y + z))).size() > 0`, cel.BoolType)
+	require.NoError(t, err)
+	s, err = CompileCel(`request.json_body().ids.all(x, x > 0 && request.json_body().ids.exists(y, y == x))`, cel.BoolType)
+	require.NoError(t, err)
+	res, err := s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, envoy.NewBufferInstance(ids))
+	require.NoError(t, err)
+	require.Equal(t, true, res)
+
+	s, err = CompileCel(`request.header("x-id") == "1" && request.body().contains("1")`, cel.BoolType)
+	require.NoError(t, err)
+	res, err = s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, envoy.NewBufferInstance(ids))
+	require.NoError(t, err)
+	require.Equal(t, true, res)
+
+	costLimit = 0
+	evalTimeout = time.Nanosecond
+	s, err = CompileCel(`request.json_body().ids.all(x, request.json_body().ids.all(y, x == y))`, cel.BoolType)
+	require.NoError(t, err)
+	_, err = s.EvalWithRequestBody(envoy.NewFilterCallbackHandler(), hdr, envoy.NewBufferInstance(ids))
+	require.ErrorContains(t, err, "operation interrupted")
+}
+
+func TestLoadLimits(t *testing.T) {
+	origCostLimit := costLimit
+	origEvalTimeout := evalTimeout
+	defer func() {
+		costLimit = origCostLimit
+		evalTimeout = origEvalTimeout
+	}()
+
+	t.Setenv("HTNN_CEL_COST_LIMIT", "100")
+	t.Setenv("HTNN_CEL_EVAL_TIMEOUT", "10ms")
+	loadLimits()
+	require.Equal(t, uint64(100), costLimit)
+	require.Equal(t, 10*time.Millisecond, evalTimeout)
+
+	t.Setenv("HTNN_CEL_COST_LIMIT", "-1")
+	t.Setenv("HTNN_CEL_EVAL_TIMEOUT", "10")
+	loadLimits()
+	require.Equal(t, uint64(100), costLimit)
+	require.Equal(t, 10*time.Millisecond, evalTimeout)
+}
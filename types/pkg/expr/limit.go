@@ -0,0 +1,76 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/cel-go/checker"
+)
+
+const (
+	// DefaultCostLimit is large enough for the common expressions, like checking a few headers
+	// or iterating a small list, but stops the expressions which take too much CPU.
+	DefaultCostLimit uint64 = 1000000
+
+	// maxRequestDataSize is the size assumed for the data which is unknown before the evaluation,
+	// like the request body. It is the default buffer limit of Envoy.
+	maxRequestDataSize = 1 << 20
+
+	// evaluate the interrupt condition every N iterations of a comprehension
+	interruptCheckFrequency = 100
+)
+
+var (
+	// costLimit is the maximum cost of an evaluation. 0 means unlimited.
+	costLimit = DefaultCostLimit
+	// evalTimeout is the maximum time of an evaluation. 0 means unlimited.
+	evalTimeout time.Duration
+)
+
+// loadLimits reads the limits from the environment variables. It is called before creating the CEL
+// environment, so the limits apply to all the expressions in this process.
+func loadLimits() {
+	if s := os.Getenv("HTNN_CEL_COST_LIMIT"); s != "" {
+		limit, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			logger.Error(err, "invalid HTNN_CEL_COST_LIMIT, use the default value", "value", s)
+		} else {
+			costLimit = limit
+		}
+	}
+	if s := os.Getenv("HTNN_CEL_EVAL_TIMEOUT"); s != "" {
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			logger.Error(err, "invalid HTNN_CEL_EVAL_TIMEOUT, ignored", "value", s)
+		} else {
+			evalTimeout = timeout
+		}
+	}
+}
+
+// costEstimator uses the default estimation for all the functions. The size of the data read during
+// the evaluation is assumed to be maxRequestDataSize at most.
+type costEstimator struct{}
+
+func (costEstimator) EstimateSize(element checker.AstNode) *checker.SizeEstimate {
+	return &checker.SizeEstimate{Min: 0, Max: maxRequestDataSize}
+}
+
+func (costEstimator) EstimateCallCost(function, overloadID string, target *checker.AstNode, args []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
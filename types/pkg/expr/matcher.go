@@ -16,12 +16,34 @@ package expr
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"regexp/syntax"
 	"strings"
 
 	api "mosn.io/htnn/types/plugins/api/v1"
 )
 
+// MaxRegexProgramSize is the maximum program size of a regex. Like RE2, the program size is the
+// number of instructions after compilation. It prevents the regex from using too much CPU and memory.
+const MaxRegexProgramSize = 1000
+
+// CompileRegex compiles the regex and rejects it if its program size exceeds MaxRegexProgramSize.
+func CompileRegex(expr string) (*regexp.Regexp, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, err
+	}
+	if n := len(prog.Inst); n > MaxRegexProgramSize {
+		return nil, fmt.Errorf("regex %q is too complex: program size %d exceeds the limit %d", expr, n, MaxRegexProgramSize)
+	}
+	return regexp.Compile(expr)
+}
+
 type Matcher interface {
 	Match(s string) bool
 	IgnoreCase() bool
@@ -162,7 +184,7 @@ func buildRepeatedStringMatcher(matchers []*api.StringMatcher, allIgnoreCase boo
 			if ignoreCase && !strings.HasPrefix(target, "(?i)") {
 				target = "(?i)" + target
 			}
-			re, err := regexp.Compile(target)
+			re, err := CompileRegex(target)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,241 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// HeaderMatcher matches a header of the request or the response
+type HeaderMatcher struct {
+	name string
+
+	stringMatcher Matcher
+	// nil means checking the presence
+	presentMatch *bool
+	rangeMatch   *v1.Int64Range
+
+	invert              bool
+	treatMissingAsEmpty bool
+}
+
+func BuildHeaderMatcher(m *v1.HeaderMatcher) (*HeaderMatcher, error) {
+	hm := &HeaderMatcher{
+		name:                strings.ToLower(m.Name),
+		invert:              m.InvertMatch,
+		treatMissingAsEmpty: m.TreatMissingHeaderAsEmpty,
+	}
+	switch v := m.HeaderMatchSpecifier.(type) {
+	case *v1.HeaderMatcher_StringMatch:
+		sm, err := BuildStringMatcher(v.StringMatch)
+		if err != nil {
+			return nil, err
+		}
+		hm.stringMatcher = sm
+	case *v1.HeaderMatcher_PresentMatch:
+		present := v.PresentMatch
+		hm.presentMatch = &present
+	case *v1.HeaderMatcher_RangeMatch:
+		if v.RangeMatch.Start >= v.RangeMatch.End {
+			return nil, fmt.Errorf("invalid range [%d, %d)", v.RangeMatch.Start, v.RangeMatch.End)
+		}
+		hm.rangeMatch = v.RangeMatch
+	}
+	return hm, nil
+}
+
+func (m *HeaderMatcher) Match(headers api.HeaderMap) bool {
+	values := headers.Values(m.name)
+	return m.match(values) != m.invert
+}
+
+func (m *HeaderMatcher) match(values []string) bool {
+	present := len(values) > 0
+	switch {
+	case m.stringMatcher != nil:
+		if !present && !m.treatMissingAsEmpty {
+			return false
+		}
+		return m.stringMatcher.Match(strings.Join(values, ","))
+	case m.rangeMatch != nil:
+		if !present {
+			return false
+		}
+		n, err := strconv.ParseInt(strings.Join(values, ","), 10, 64)
+		if err != nil {
+			return false
+		}
+		return m.rangeMatch.Start <= n && n < m.rangeMatch.End
+	case m.presentMatch != nil:
+		return present == *m.presentMatch
+	default:
+		return present
+	}
+}
+
+// QueryParameterMatcher matches a query parameter of the request
+type QueryParameterMatcher struct {
+	name string
+
+	stringMatcher Matcher
+	// nil means checking the presence
+	presentMatch *bool
+
+	invert bool
+}
+
+func BuildQueryParameterMatcher(m *v1.QueryParameterMatcher) (*QueryParameterMatcher, error) {
+	qm := &QueryParameterMatcher{
+		name:   m.Name,
+		invert: m.InvertMatch,
+	}
+	switch v := m.QueryParameterMatchSpecifier.(type) {
+	case *v1.QueryParameterMatcher_StringMatch:
+		sm, err := BuildStringMatcher(v.StringMatch)
+		if err != nil {
+			return nil, err
+		}
+		qm.stringMatcher = sm
+	case *v1.QueryParameterMatcher_PresentMatch:
+		present := v.PresentMatch
+		qm.presentMatch = &present
+	}
+	return qm, nil
+}
+
+func (m *QueryParameterMatcher) Match(query url.Values) bool {
+	return m.match(query) != m.invert
+}
+
+func (m *QueryParameterMatcher) match(query url.Values) bool {
+	values, present := query[m.name]
+	switch {
+	case m.stringMatcher != nil:
+		if !present || len(values) == 0 {
+			return false
+		}
+		return m.stringMatcher.Match(values[0])
+	case m.presentMatch != nil:
+		return present == *m.presentMatch
+	default:
+		return present
+	}
+}
+
+// RequestMatcher matches the request when all the configured conditions match
+type RequestMatcher struct {
+	path    Matcher
+	methods []string
+	headers []*HeaderMatcher
+	queries []*QueryParameterMatcher
+}
+
+func BuildRequestMatcher(m *v1.RequestMatcher) (*RequestMatcher, error) {
+	rm := &RequestMatcher{
+		methods: m.Methods,
+	}
+	if m.Path != nil {
+		pm, err := BuildStringMatcher(m.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path: %w", err)
+		}
+		rm.path = pm
+	}
+	for i, h := range m.Headers {
+		hm, err := BuildHeaderMatcher(h)
+		if err != nil {
+			return nil, fmt.Errorf("invalid headers[%d]: %w", i, err)
+		}
+		rm.headers = append(rm.headers, hm)
+	}
+	for i, q := range m.QueryParameters {
+		qm, err := BuildQueryParameterMatcher(q)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query_parameters[%d]: %w", i, err)
+		}
+		rm.queries = append(rm.queries, qm)
+	}
+	return rm, nil
+}
+
+// BuildRequestMatchers builds the matchers which match the request when any of them matches
+func BuildRequestMatchers(matchers []*v1.RequestMatcher) ([]*RequestMatcher, error) {
+	built := make([]*RequestMatcher, 0, len(matchers))
+	for i, m := range matchers {
+		rm, err := BuildRequestMatcher(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %d: %w", i, err)
+		}
+		built = append(built, rm)
+	}
+	return built, nil
+}
+
+func (m *RequestMatcher) Match(headers api.RequestHeaderMap) bool {
+	if len(m.methods) > 0 {
+		method := headers.Method()
+		found := false
+		for _, allowed := range m.methods {
+			if strings.EqualFold(method, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if m.path != nil || len(m.queries) > 0 {
+		u := headers.URL()
+		if u == nil {
+			return false
+		}
+		if m.path != nil && !m.path.Match(u.Path) {
+			return false
+		}
+		if len(m.queries) > 0 {
+			query := u.Query()
+			for _, qm := range m.queries {
+				if !qm.Match(query) {
+					return false
+				}
+			}
+		}
+	}
+
+	for _, hm := range m.headers {
+		if !hm.Match(headers) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchAny returns true if any of the matchers matches the request
+func MatchAny(matchers []*RequestMatcher, headers api.RequestHeaderMap) bool {
+	for _, m := range matchers {
+		if m.Match(headers) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	api "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        string
+		matched    []http.Header
+		mismatched []http.Header
+		err        string
+	}{
+		{
+			name:       "present by default",
+			cfg:        `{"name":"X-Foo"}`,
+			matched:    []http.Header{{"X-Foo": []string{""}}, {"X-Foo": []string{"bar"}}},
+			mismatched: []http.Header{{}},
+		},
+		{
+			name:       "absent",
+			cfg:        `{"name":"x-foo","present_match":false}`,
+			matched:    []http.Header{{}},
+			mismatched: []http.Header{{"X-Foo": []string{"bar"}}},
+		},
+		{
+			name:       "string match",
+			cfg:        `{"name":"x-foo","string_match":{"prefix":"b"}}`,
+			matched:    []http.Header{{"X-Foo": []string{"bar"}}, {"X-Foo": []string{"b", "c"}}},
+			mismatched: []http.Header{{}, {"X-Foo": []string{"car"}}},
+		},
+		{
+			name:       "multiple values",
+			cfg:        `{"name":"x-foo","string_match":{"exact":"a,b"}}`,
+			matched:    []http.Header{{"X-Foo": []string{"a", "b"}}},
+			mismatched: []http.Header{{"X-Foo": []string{"a"}}},
+		},
+		{
+			name:       "invert",
+			cfg:        `{"name":"x-foo","string_match":{"exact":"bar"},"invert_match":true}`,
+			matched:    []http.Header{{}, {"X-Foo": []string{"car"}}},
+			mismatched: []http.Header{{"X-Foo": []string{"bar"}}},
+		},
+		{
+			name:       "treat missing header as empty",
+			cfg:        `{"name":"x-foo","string_match":{"regex":"^$"},"treat_missing_header_as_empty":true}`,
+			matched:    []http.Header{{}},
+			mismatched: []http.Header{{"X-Foo": []string{"bar"}}},
+		},
+		{
+			name:       "range",
+			cfg:        `{"name":"x-foo","range_match":{"start":-10,"end":0}}`,
+			matched:    []http.Header{{"X-Foo": []string{"-1"}}, {"X-Foo": []string{"-10"}}},
+			mismatched: []http.Header{{}, {"X-Foo": []string{"0"}}, {"X-Foo": []string{"-1s"}}, {"X-Foo": []string{"-9.1"}}},
+		},
+		{
+			name: "invalid range",
+			cfg:  `{"name":"x-foo","range_match":{"start":1,"end":1}}`,
+			err:  "invalid range [1, 1)",
+		},
+		{
+			name: "invalid regex",
+			cfg:  `{"name":"x-foo","string_match":{"regex":"(?!)a"}}`,
+			err:  "invalid or unsupported Perl syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &api.HeaderMatcher{}
+			require.NoError(t, protojson.Unmarshal([]byte(tt.cfg), m))
+			require.NoError(t, m.Validate())
+			hm, err := BuildHeaderMatcher(m)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			for _, h := range tt.matched {
+				assert.True(t, hm.Match(envoy.NewRequestHeaderMap(h)), h)
+			}
+			for _, h := range tt.mismatched {
+				assert.False(t, hm.Match(envoy.NewRequestHeaderMap(h)), h)
+			}
+		})
+	}
+}
+
+func TestQueryParameterMatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        string
+		matched    []string
+		mismatched []string
+	}{
+		{
+			name:       "present by default",
+			cfg:        `{"name":"a"}`,
+			matched:    []string{"a=", "a=1&b=2"},
+			mismatched: []string{"", "b=1", "A=1"},
+		},
+		{
+			name:       "absent",
+			cfg:        `{"name":"a","present_match":false}`,
+			matched:    []string{"", "b=1"},
+			mismatched: []string{"a=1"},
+		},
+		{
+			name:       "string match",
+			cfg:        `{"name":"a","string_match":{"exact":"1"}}`,
+			matched:    []string{"a=1", "a=1&a=2"},
+			mismatched: []string{"", "a=2&a=1"},
+		},
+		{
+			name:       "invert",
+			cfg:        `{"name":"a","string_match":{"exact":"1"},"invert_match":true}`,
+			matched:    []string{"", "a=2"},
+			mismatched: []string{"a=1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &api.QueryParameterMatcher{}
+			require.NoError(t, protojson.Unmarshal([]byte(tt.cfg), m))
+			qm, err := BuildQueryParameterMatcher(m)
+			require.NoError(t, err)
+			for _, s := range tt.matched {
+				q, _ := url.ParseQuery(s)
+				assert.True(t, qm.Match(q), s)
+			}
+			for _, s := range tt.mismatched {
+				q, _ := url.ParseQuery(s)
+				assert.False(t, qm.Match(q), s)
+			}
+		})
+	}
+}
+
+func TestRequestMatcher(t *testing.T) {
+	matchers := []*api.RequestMatcher{}
+	for _, s := range []string{
+		`{"path":{"prefix":"/api/"},"methods":["GET","POST"],"headers":[{"name":"x-user"}],"query_parameters":[{"name":"debug","present_match":false}]}`,
+		`{"path":{"exact":"/health"}}`,
+	} {
+		m := &api.RequestMatcher{}
+		require.NoError(t, protojson.Unmarshal([]byte(s), m))
+		require.NoError(t, m.Validate())
+		matchers = append(matchers, m)
+	}
+	built, err := BuildRequestMatchers(matchers)
+	require.NoError(t, err)
+
+	tests := []struct {
+		headers http.Header
+		matched bool
+	}{
+		{http.Header{":path": []string{"/api/x"}, "X-User": []string{"a"}}, true},
+		{http.Header{":path": []string{"/api/x"}, ":method": []string{"post"}, "X-User": []string{"a"}}, true},
+		{http.Header{":path": []string{"/api/x"}, ":method": []string{"PUT"}, "X-User": []string{"a"}}, false},
+		{http.Header{":path": []string{"/api/x"}}, false},
+		{http.Header{":path": []string{"/api/x?debug=1"}, "X-User": []string{"a"}}, false},
+		{http.Header{":path": []string{"/health?debug=1"}}, true},
+		{http.Header{":path": []string{"/healthz"}}, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.matched, MatchAny(built, envoy.NewRequestHeaderMap(tt.headers)), tt.headers)
+	}
+
+	m := &api.RequestMatcher{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"headers":[{"name":"x","range_match":{"start":2,"end":1}}]}`), m))
+	_, err = BuildRequestMatchers([]*api.RequestMatcher{m})
+	assert.ErrorContains(t, err, "invalid matcher 0: invalid headers[0]: invalid range [2, 1)")
+}
+
+func TestCompileRegex(t *testing.T) {
+	_, err := CompileRegex(`^/api/v[0-9]+/users/[a-z]+$`)
+	require.NoError(t, err)
+	_, err = CompileRegex(`(a{100}){10}`)
+	require.ErrorContains(t, err, "is too complex")
+}
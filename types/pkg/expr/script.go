@@ -20,4 +20,12 @@ import (
 
 type Script interface {
 	EvalWithRequest(cb api.FilterCallbackHandler, headers api.RequestHeaderMap) (any, error)
+	// EvalWithRequestBody evaluates the script with the buffered request body
+	EvalWithRequestBody(cb api.FilterCallbackHandler, headers api.RequestHeaderMap, body api.BufferInstance) (any, error)
+	// EvalWithResponse evaluates the script when processing the response
+	EvalWithResponse(cb api.FilterCallbackHandler, reqHeaders api.RequestHeaderMap, respHeaders api.ResponseHeaderMap) (any, error)
+	// EvalOnLog evaluates the script in the OnLog phase, which can access the final stream info
+	EvalOnLog(cb api.FilterCallbackHandler, reqHeaders api.RequestHeaderMap, respHeaders api.ResponseHeaderMap) (any, error)
+	// NeedRequestBody returns true if the script reads the request body
+	NeedRequestBody() bool
 }
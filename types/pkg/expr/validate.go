@@ -0,0 +1,86 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	api "mosn.io/htnn/types/plugins/api/v1"
+)
+
+var celTypes = map[api.CelType]*cel.Type{
+	api.CelType_CEL_TYPE_STRING: cel.StringType,
+	api.CelType_CEL_TYPE_BOOL:   cel.BoolType,
+}
+
+// ValidateCelFields compiles the fields which are marked as CEL expressions via the `cel` field
+// option. The error contains the location of the invalid field, like `rules[0].key`.
+func ValidateCelFields(msg proto.Message) error {
+	return validateCelFields("", msg.ProtoReflect())
+}
+
+func fieldPath(prefix string, fd protoreflect.FieldDescriptor) string {
+	if prefix == "" {
+		return string(fd.Name())
+	}
+	return prefix + "." + string(fd.Name())
+}
+
+func validateCelFields(prefix string, m protoreflect.Message) error {
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		path := fieldPath(prefix, fd)
+		switch {
+		case fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len() && err == nil; i++ {
+				err = validateCelValue(fmt.Sprintf("%s[%d]", path, i), fd, l.Get(i))
+			}
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				err = validateCelValue(fmt.Sprintf("%s[%s]", path, k.String()), fd.MapValue(), v)
+				return err == nil
+			})
+		default:
+			err = validateCelValue(path, fd, v)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func validateCelValue(path string, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return validateCelFields(path, v.Message())
+	case protoreflect.StringKind:
+		opts := fd.Options()
+		if opts == nil || !proto.HasExtension(opts, api.E_Cel) {
+			return nil
+		}
+		typ, ok := celTypes[proto.GetExtension(opts, api.E_Cel).(api.CelType)]
+		if !ok {
+			return nil
+		}
+		if _, err := CompileCel(v.String(), typ); err != nil {
+			return fmt.Errorf("invalid %s: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"mosn.io/htnn/types/plugins/celscript"
+	"mosn.io/htnn/types/plugins/limitcountredis"
+)
+
+func TestValidateCelFields(t *testing.T) {
+	tests := []struct {
+		name string
+		conf proto.Message
+		err  string
+	}{
+		{
+			name: "empty",
+			conf: &celscript.Config{},
+		},
+		{
+			name: "ok",
+			conf: &celscript.Config{AllowIf: `request.path() == "/"`},
+		},
+		{
+			name: "wrong return type",
+			conf: &celscript.Config{AllowIf: `request.path()`},
+			err:  "invalid allow_if: got string, wanted bool",
+		},
+		{
+			name: "repeated",
+			conf: &limitcountredis.Config{
+				Rules: []*limitcountredis.Rule{
+					{Key: `request.header("x")`},
+					{},
+					{Key: `request.headers`},
+				},
+			},
+			err: "invalid rules[2].key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCelFields(tt.conf)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
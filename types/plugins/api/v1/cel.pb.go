@@ -0,0 +1,184 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/api/v1/cel.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The return type of a CEL expression
+type CelType int32
+
+const (
+	CelType_CEL_TYPE_UNSPECIFIED CelType = 0
+	CelType_CEL_TYPE_STRING      CelType = 1
+	CelType_CEL_TYPE_BOOL        CelType = 2
+)
+
+// Enum value maps for CelType.
+var (
+	CelType_name = map[int32]string{
+		0: "CEL_TYPE_UNSPECIFIED",
+		1: "CEL_TYPE_STRING",
+		2: "CEL_TYPE_BOOL",
+	}
+	CelType_value = map[string]int32{
+		"CEL_TYPE_UNSPECIFIED": 0,
+		"CEL_TYPE_STRING":      1,
+		"CEL_TYPE_BOOL":        2,
+	}
+)
+
+func (x CelType) Enum() *CelType {
+	p := new(CelType)
+	*p = x
+	return p
+}
+
+func (x CelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_api_v1_cel_proto_enumTypes[0].Descriptor()
+}
+
+func (CelType) Type() protoreflect.EnumType {
+	return &file_types_plugins_api_v1_cel_proto_enumTypes[0]
+}
+
+func (x CelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CelType.Descriptor instead.
+func (CelType) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_api_v1_cel_proto_rawDescGZIP(), []int{0}
+}
+
+var file_types_plugins_api_v1_cel_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*CelType)(nil),
+		Field:         50001,
+		Name:          "types.plugins.api.v1.cel",
+		Tag:           "varint,50001,opt,name=cel,enum=types.plugins.api.v1.CelType",
+		Filename:      "types/plugins/api/v1/cel.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Mark the string field as a CEL expression which returns the given type.
+	// The expression is compiled when validating the configuration in the control plane.
+	//
+	// optional types.plugins.api.v1.CelType cel = 50001;
+	E_Cel = &file_types_plugins_api_v1_cel_proto_extTypes[0]
+)
+
+var File_types_plugins_api_v1_cel_proto protoreflect.FileDescriptor
+
+var file_types_plugins_api_v1_cel_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x4b, 0x0a, 0x07, 0x43, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x43, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
+	0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x3a, 0x50, 0x0a, 0x03, 0x63, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x03, 0x63, 0x65, 0x6c, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x6f, 0x73, 0x6e, 0x2e,
+	0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_api_v1_cel_proto_rawDescOnce sync.Once
+	file_types_plugins_api_v1_cel_proto_rawDescData = file_types_plugins_api_v1_cel_proto_rawDesc
+)
+
+func file_types_plugins_api_v1_cel_proto_rawDescGZIP() []byte {
+	file_types_plugins_api_v1_cel_proto_rawDescOnce.Do(func() {
+		file_types_plugins_api_v1_cel_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_api_v1_cel_proto_rawDescData)
+	})
+	return file_types_plugins_api_v1_cel_proto_rawDescData
+}
+
+var file_types_plugins_api_v1_cel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_plugins_api_v1_cel_proto_goTypes = []interface{}{
+	(CelType)(0),                      // 0: types.plugins.api.v1.CelType
+	(*descriptorpb.FieldOptions)(nil), // 1: google.protobuf.FieldOptions
+}
+var file_types_plugins_api_v1_cel_proto_depIdxs = []int32{
+	1, // 0: types.plugins.api.v1.cel:extendee -> google.protobuf.FieldOptions
+	0, // 1: types.plugins.api.v1.cel:type_name -> types.plugins.api.v1.CelType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_api_v1_cel_proto_init() }
+func file_types_plugins_api_v1_cel_proto_init() {
+	if File_types_plugins_api_v1_cel_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_api_v1_cel_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_api_v1_cel_proto_goTypes,
+		DependencyIndexes: file_types_plugins_api_v1_cel_proto_depIdxs,
+		EnumInfos:         file_types_plugins_api_v1_cel_proto_enumTypes,
+		ExtensionInfos:    file_types_plugins_api_v1_cel_proto_extTypes,
+	}.Build()
+	File_types_plugins_api_v1_cel_proto = out.File
+	file_types_plugins_api_v1_cel_proto_rawDesc = nil
+	file_types_plugins_api_v1_cel_proto_goTypes = nil
+	file_types_plugins_api_v1_cel_proto_depIdxs = nil
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: types/plugins/api/v1/claim.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ClaimToHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimToHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimToHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimToHeaderMultiError, or
+// nil if none found.
+func (m *ClaimToHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimToHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClaim()) < 1 {
+		err := ClaimToHeaderValidationError{
+			field:  "Claim",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClaimToHeader_Header_Pattern.MatchString(m.GetHeader()) {
+		err := ClaimToHeaderValidationError{
+			field:  "Header",
+			reason: "value does not match regex pattern \"^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimToHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimToHeaderMultiError is an error wrapping multiple validation errors
+// returned by ClaimToHeader.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimToHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimToHeaderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimToHeaderMultiError) AllErrors() []error { return m }
+
+// ClaimToHeaderValidationError is the validation error returned by
+// ClaimToHeader.Validate if the designated constraints aren't met.
+type ClaimToHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimToHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimToHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimToHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimToHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimToHeaderValidationError) ErrorName() string { return "ClaimToHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimToHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimToHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimToHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimToHeaderValidationError{}
+
+var _ClaimToHeader_Header_Pattern = regexp.MustCompile("^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$")
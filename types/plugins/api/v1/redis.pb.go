@@ -0,0 +1,321 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/api/v1/redis.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RedisCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *RedisCluster) Reset() {
+	*x = RedisCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_api_v1_redis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedisCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedisCluster) ProtoMessage() {}
+
+func (x *RedisCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_api_v1_redis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedisCluster.ProtoReflect.Descriptor instead.
+func (*RedisCluster) Descriptor() ([]byte, []int) {
+	return file_types_plugins_api_v1_redis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RedisCluster) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// Redis configures the Redis used to share the data across gateway replicas.
+type Redis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*Redis_Address
+	//	*Redis_Cluster
+	Source        isRedis_Source `protobuf_oneof:"source"`
+	Username      string         `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string         `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Tls           bool           `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	TlsSkipVerify bool           `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// The prefix of the keys in Redis. The default value is decided by the plugin.
+	Prefix string `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *Redis) Reset() {
+	*x = Redis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_api_v1_redis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redis) ProtoMessage() {}
+
+func (x *Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_api_v1_redis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redis.ProtoReflect.Descriptor instead.
+func (*Redis) Descriptor() ([]byte, []int) {
+	return file_types_plugins_api_v1_redis_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Redis) GetSource() isRedis_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *Redis) GetAddress() string {
+	if x, ok := x.GetSource().(*Redis_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Redis) GetCluster() *RedisCluster {
+	if x, ok := x.GetSource().(*Redis_Cluster); ok {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *Redis) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Redis) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *Redis) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *Redis) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type isRedis_Source interface {
+	isRedis_Source()
+}
+
+type Redis_Address struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3,oneof"`
+}
+
+type Redis_Cluster struct {
+	Cluster *RedisCluster `protobuf:"bytes,2,opt,name=cluster,proto3,oneof"`
+}
+
+func (*Redis_Address) isRedis_Source() {}
+
+func (*Redis_Cluster) isRedis_Source() {}
+
+var File_types_plugins_api_v1_redis_proto protoreflect.FileDescriptor
+
+var file_types_plugins_api_v1_redis_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x64, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x05, 0x52, 0x65,
+	0x64, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3e, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73,
+	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f,
+	0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_api_v1_redis_proto_rawDescOnce sync.Once
+	file_types_plugins_api_v1_redis_proto_rawDescData = file_types_plugins_api_v1_redis_proto_rawDesc
+)
+
+func file_types_plugins_api_v1_redis_proto_rawDescGZIP() []byte {
+	file_types_plugins_api_v1_redis_proto_rawDescOnce.Do(func() {
+		file_types_plugins_api_v1_redis_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_api_v1_redis_proto_rawDescData)
+	})
+	return file_types_plugins_api_v1_redis_proto_rawDescData
+}
+
+var file_types_plugins_api_v1_redis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_types_plugins_api_v1_redis_proto_goTypes = []interface{}{
+	(*RedisCluster)(nil), // 0: types.plugins.api.v1.RedisCluster
+	(*Redis)(nil),        // 1: types.plugins.api.v1.Redis
+}
+var file_types_plugins_api_v1_redis_proto_depIdxs = []int32{
+	0, // 0: types.plugins.api.v1.Redis.cluster:type_name -> types.plugins.api.v1.RedisCluster
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_api_v1_redis_proto_init() }
+func file_types_plugins_api_v1_redis_proto_init() {
+	if File_types_plugins_api_v1_redis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_plugins_api_v1_redis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedisCluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_api_v1_redis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Redis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_api_v1_redis_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Redis_Address)(nil),
+		(*Redis_Cluster)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_api_v1_redis_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_api_v1_redis_proto_goTypes,
+		DependencyIndexes: file_types_plugins_api_v1_redis_proto_depIdxs,
+		MessageInfos:      file_types_plugins_api_v1_redis_proto_msgTypes,
+	}.Build()
+	File_types_plugins_api_v1_redis_proto = out.File
+	file_types_plugins_api_v1_redis_proto_rawDesc = nil
+	file_types_plugins_api_v1_redis_proto_goTypes = nil
+	file_types_plugins_api_v1_redis_proto_depIdxs = nil
+}
@@ -0,0 +1,124 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+)
+
+const (
+	Name = "basicAuth"
+)
+
+// The upper bounds of the password hash parameters, so that a consumer can't make each
+// verification exhaust the CPU or the memory
+const (
+	minBcryptCost    = 4
+	maxBcryptCost    = 14
+	maxArgon2Time    = 16
+	maxArgon2Memory  = 256 * 1024 // KiB
+	maxArgon2Threads = 16
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &Config{}
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &CustomConsumerConfig{}
+}
+
+func (conf *ConsumerConfig) Index() string {
+	return conf.Username
+}
+
+type CustomConsumerConfig struct {
+	ConsumerConfig
+}
+
+func (conf *CustomConsumerConfig) Validate() error {
+	err := conf.ConsumerConfig.Validate()
+	if err != nil {
+		return err
+	}
+
+	hash := conf.Password
+	if strings.HasPrefix(hash, "$argon2id$") {
+		return validateArgon2id(hash)
+	}
+	return validateBcrypt(hash)
+}
+
+// validateArgon2id checks the hash in PHC string format: $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+func validateArgon2id(hash string) error {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return errors.New("invalid argon2id hash")
+	}
+	var memory, time, threads uint64
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if time < 1 || time > maxArgon2Time {
+		return fmt.Errorf("argon2id time should be in [1, %d], got %d", maxArgon2Time, time)
+	}
+	if memory < 1 || memory > maxArgon2Memory {
+		return fmt.Errorf("argon2id memory should be in [1, %d], got %d", maxArgon2Memory, memory)
+	}
+	if threads < 1 || threads > maxArgon2Threads {
+		return fmt.Errorf("argon2id threads should be in [1, %d], got %d", maxArgon2Threads, threads)
+	}
+	return nil
+}
+
+// validateBcrypt checks the cost of the hash in the format of $2y$<cost>$<salt and hash>
+func validateBcrypt(hash string) error {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 {
+		return errors.New("invalid bcrypt hash")
+	}
+	cost, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid bcrypt cost: %w", err)
+	}
+	if cost < minBcryptCost || cost > maxBcryptCost {
+		return fmt.Errorf("bcrypt cost should be in [%d, %d], got %d", minBcryptCost, maxBcryptCost, cost)
+	}
+	return nil
+}
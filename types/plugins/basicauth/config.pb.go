@@ -0,0 +1,257 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/basicauth/config.proto
+
+package basicauth
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The realm in the WWW-Authenticate challenge. Default to "htnn".
+	Realm string `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Whether to remove the Authorization header before forwarding the request to the upstream.
+	HideCredentials bool `protobuf:"varint,2,opt,name=hide_credentials,json=hideCredentials,proto3" json:"hide_credentials,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_basicauth_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_basicauth_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_types_plugins_basicauth_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+func (x *Config) GetHideCredentials() bool {
+	if x != nil {
+		return x.HideCredentials
+	}
+	return false
+}
+
+type ConsumerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The username. It is used to find the consumer.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The password hash in the htpasswd style. Both bcrypt (``$2y$...``) and
+	// argon2id in PHC string format (``$argon2id$v=19$m=...,t=...,p=...$<salt>$<hash>``)
+	// are supported.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ConsumerConfig) Reset() {
+	*x = ConsumerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_basicauth_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerConfig) ProtoMessage() {}
+
+func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_basicauth_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerConfig.ProtoReflect.Descriptor instead.
+func (*ConsumerConfig) Descriptor() ([]byte, []int) {
+	return file_types_plugins_basicauth_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsumerConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+var File_types_plugins_basicauth_config_proto protoreflect.FileDescriptor
+
+var file_types_plugins_basicauth_config_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x72, 0x0e, 0x32, 0x09, 0x5e, 0x5b, 0x5e, 0x22, 0x5c, 0x5c,
+	0x5d, 0x2a, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x29, 0x0a,
+	0x10, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x69, 0x64, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x7b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42,
+	0x0d, 0x72, 0x0b, 0x10, 0x01, 0x32, 0x07, 0x5e, 0x5b, 0x5e, 0x3a, 0x5d, 0x2b, 0x24, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72,
+	0x1a, 0x32, 0x18, 0x5e, 0x5c, 0x24, 0x28, 0x32, 0x5b, 0x61, 0x62, 0x78, 0x79, 0x5d, 0x3f, 0x7c,
+	0x61, 0x72, 0x67, 0x6f, 0x6e, 0x32, 0x69, 0x64, 0x29, 0x5c, 0x24, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x26, 0x5a, 0x24, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f,
+	0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_basicauth_config_proto_rawDescOnce sync.Once
+	file_types_plugins_basicauth_config_proto_rawDescData = file_types_plugins_basicauth_config_proto_rawDesc
+)
+
+func file_types_plugins_basicauth_config_proto_rawDescGZIP() []byte {
+	file_types_plugins_basicauth_config_proto_rawDescOnce.Do(func() {
+		file_types_plugins_basicauth_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_basicauth_config_proto_rawDescData)
+	})
+	return file_types_plugins_basicauth_config_proto_rawDescData
+}
+
+var file_types_plugins_basicauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_types_plugins_basicauth_config_proto_goTypes = []interface{}{
+	(*Config)(nil),         // 0: types.plugins.basicauth.Config
+	(*ConsumerConfig)(nil), // 1: types.plugins.basicauth.ConsumerConfig
+}
+var file_types_plugins_basicauth_config_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_basicauth_config_proto_init() }
+func file_types_plugins_basicauth_config_proto_init() {
+	if File_types_plugins_basicauth_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_plugins_basicauth_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_basicauth_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_basicauth_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_basicauth_config_proto_goTypes,
+		DependencyIndexes: file_types_plugins_basicauth_config_proto_depIdxs,
+		MessageInfos:      file_types_plugins_basicauth_config_proto_msgTypes,
+	}.Build()
+	File_types_plugins_basicauth_config_proto = out.File
+	file_types_plugins_basicauth_config_proto_rawDesc = nil
+	file_types_plugins_basicauth_config_proto_goTypes = nil
+	file_types_plugins_basicauth_config_proto_depIdxs = nil
+}
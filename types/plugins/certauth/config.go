@@ -0,0 +1,81 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certauth
+
+import (
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+)
+
+const (
+	Name = "certAuth"
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &Config{}
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &ConsumerConfig{}
+}
+
+// IndexOf returns the consumer index of the given identity. As different kinds of identities
+// share the same index, the kind is used as the prefix to avoid collision.
+func IndexOf(id Identity, value string) string {
+	switch id {
+	case Identity_SUBJECT:
+		return "subject:" + value
+	case Identity_URI_SAN:
+		return "uri:" + value
+	case Identity_DNS_SAN:
+		return "dns:" + value
+	default:
+		return "sha256:" + strings.ToLower(strings.ReplaceAll(value, ":", ""))
+	}
+}
+
+func (conf *ConsumerConfig) Index() string {
+	switch id := conf.Identity.(type) {
+	case *ConsumerConfig_Subject:
+		return IndexOf(Identity_SUBJECT, id.Subject)
+	case *ConsumerConfig_UriSan:
+		return IndexOf(Identity_URI_SAN, id.UriSan)
+	case *ConsumerConfig_DnsSan:
+		return IndexOf(Identity_DNS_SAN, id.DnsSan)
+	case *ConsumerConfig_Sha256Fingerprint:
+		return IndexOf(Identity_SHA256_FINGERPRINT, id.Sha256Fingerprint)
+	}
+	return ""
+}
@@ -0,0 +1,377 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/certauth/config.proto
+
+package certauth
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Identity int32
+
+const (
+	// The SHA-256 fingerprint of the client certificate
+	Identity_SHA256_FINGERPRINT Identity = 0
+	// The first URI SAN of the client certificate
+	Identity_URI_SAN Identity = 1
+	// The first DNS SAN of the client certificate
+	Identity_DNS_SAN Identity = 2
+	// The subject DN of the client certificate
+	Identity_SUBJECT Identity = 3
+)
+
+// Enum value maps for Identity.
+var (
+	Identity_name = map[int32]string{
+		0: "SHA256_FINGERPRINT",
+		1: "URI_SAN",
+		2: "DNS_SAN",
+		3: "SUBJECT",
+	}
+	Identity_value = map[string]int32{
+		"SHA256_FINGERPRINT": 0,
+		"URI_SAN":            1,
+		"DNS_SAN":            2,
+		"SUBJECT":            3,
+	}
+)
+
+func (x Identity) Enum() *Identity {
+	p := new(Identity)
+	*p = x
+	return p
+}
+
+func (x Identity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Identity) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_certauth_config_proto_enumTypes[0].Descriptor()
+}
+
+func (Identity) Type() protoreflect.EnumType {
+	return &file_types_plugins_certauth_config_proto_enumTypes[0]
+}
+
+func (x Identity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Identity.Descriptor instead.
+func (Identity) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_certauth_config_proto_rawDescGZIP(), []int{0}
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identities of the client certificate used to find the consumer. They are tried in order.
+	// Default to [SHA256_FINGERPRINT, URI_SAN, DNS_SAN, SUBJECT].
+	Identities []Identity `protobuf:"varint,1,rep,packed,name=identities,proto3,enum=types.plugins.certauth.Identity" json:"identities,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_certauth_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_certauth_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_types_plugins_certauth_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetIdentities() []Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type ConsumerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Identity:
+	//	*ConsumerConfig_Subject
+	//	*ConsumerConfig_UriSan
+	//	*ConsumerConfig_DnsSan
+	//	*ConsumerConfig_Sha256Fingerprint
+	Identity isConsumerConfig_Identity `protobuf_oneof:"identity"`
+}
+
+func (x *ConsumerConfig) Reset() {
+	*x = ConsumerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_certauth_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerConfig) ProtoMessage() {}
+
+func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_certauth_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerConfig.ProtoReflect.Descriptor instead.
+func (*ConsumerConfig) Descriptor() ([]byte, []int) {
+	return file_types_plugins_certauth_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *ConsumerConfig) GetIdentity() isConsumerConfig_Identity {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+func (x *ConsumerConfig) GetSubject() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_Subject); ok {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetUriSan() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_UriSan); ok {
+		return x.UriSan
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetDnsSan() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_DnsSan); ok {
+		return x.DnsSan
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetSha256Fingerprint() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_Sha256Fingerprint); ok {
+		return x.Sha256Fingerprint
+	}
+	return ""
+}
+
+type isConsumerConfig_Identity interface {
+	isConsumerConfig_Identity()
+}
+
+type ConsumerConfig_Subject struct {
+	// The subject DN in RFC 2253 format, like ``CN=client,O=example``.
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3,oneof"`
+}
+
+type ConsumerConfig_UriSan struct {
+	UriSan string `protobuf:"bytes,2,opt,name=uri_san,json=uriSan,proto3,oneof"`
+}
+
+type ConsumerConfig_DnsSan struct {
+	DnsSan string `protobuf:"bytes,3,opt,name=dns_san,json=dnsSan,proto3,oneof"`
+}
+
+type ConsumerConfig_Sha256Fingerprint struct {
+	// The hex encoded SHA-256 fingerprint. The bytes can be separated by colons.
+	Sha256Fingerprint string `protobuf:"bytes,4,opt,name=sha256_fingerprint,json=sha256Fingerprint,proto3,oneof"`
+}
+
+func (*ConsumerConfig_Subject) isConsumerConfig_Identity() {}
+
+func (*ConsumerConfig_UriSan) isConsumerConfig_Identity() {}
+
+func (*ConsumerConfig_DnsSan) isConsumerConfig_Identity() {}
+
+func (*ConsumerConfig_Sha256Fingerprint) isConsumerConfig_Identity() {}
+
+var File_types_plugins_certauth_config_proto protoreflect.FileDescriptor
+
+var file_types_plugins_certauth_config_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x63, 0x65, 0x72, 0x74, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x18, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xee,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x23, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x75, 0x72, 0x69, 0x5f, 0x73, 0x61,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x48, 0x00, 0x52, 0x06, 0x75, 0x72, 0x69, 0x53, 0x61, 0x6e, 0x12, 0x22, 0x0a, 0x07, 0x64, 0x6e,
+	0x73, 0x5f, 0x73, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6e, 0x73, 0x53, 0x61, 0x6e, 0x12, 0x5e,
+	0x0a, 0x12, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x42, 0x2a, 0x72,
+	0x28, 0x32, 0x26, 0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d,
+	0x7b, 0x32, 0x7d, 0x3a, 0x3f, 0x29, 0x7b, 0x33, 0x31, 0x7d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
+	0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x48, 0x00, 0x52, 0x11, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x0f,
+	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x2a,
+	0x49, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x5f, 0x46, 0x49, 0x4e, 0x47, 0x45, 0x52, 0x50, 0x52, 0x49, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x52, 0x49, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x4e, 0x53, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x42, 0x25, 0x5a, 0x23, 0x6d, 0x6f,
+	0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x61, 0x75, 0x74,
+	0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_certauth_config_proto_rawDescOnce sync.Once
+	file_types_plugins_certauth_config_proto_rawDescData = file_types_plugins_certauth_config_proto_rawDesc
+)
+
+func file_types_plugins_certauth_config_proto_rawDescGZIP() []byte {
+	file_types_plugins_certauth_config_proto_rawDescOnce.Do(func() {
+		file_types_plugins_certauth_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_certauth_config_proto_rawDescData)
+	})
+	return file_types_plugins_certauth_config_proto_rawDescData
+}
+
+var file_types_plugins_certauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_plugins_certauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_types_plugins_certauth_config_proto_goTypes = []interface{}{
+	(Identity)(0),          // 0: types.plugins.certauth.Identity
+	(*Config)(nil),         // 1: types.plugins.certauth.Config
+	(*ConsumerConfig)(nil), // 2: types.plugins.certauth.ConsumerConfig
+}
+var file_types_plugins_certauth_config_proto_depIdxs = []int32{
+	0, // 0: types.plugins.certauth.Config.identities:type_name -> types.plugins.certauth.Identity
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_certauth_config_proto_init() }
+func file_types_plugins_certauth_config_proto_init() {
+	if File_types_plugins_certauth_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_plugins_certauth_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_certauth_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_certauth_config_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*ConsumerConfig_Subject)(nil),
+		(*ConsumerConfig_UriSan)(nil),
+		(*ConsumerConfig_DnsSan)(nil),
+		(*ConsumerConfig_Sha256Fingerprint)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_certauth_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_certauth_config_proto_goTypes,
+		DependencyIndexes: file_types_plugins_certauth_config_proto_depIdxs,
+		EnumInfos:         file_types_plugins_certauth_config_proto_enumTypes,
+		MessageInfos:      file_types_plugins_certauth_config_proto_msgTypes,
+	}.Build()
+	File_types_plugins_certauth_config_proto = out.File
+	file_types_plugins_certauth_config_proto_rawDesc = nil
+	file_types_plugins_certauth_config_proto_goTypes = nil
+	file_types_plugins_certauth_config_proto_depIdxs = nil
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: types/plugins/certauth/config.proto
+
+package certauth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Config) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ConfigMultiError, or nil if none found.
+func (m *Config) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Config) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIdentities()) > 0 {
+
+		_Config_Identities_Unique := make(map[Identity]struct{}, len(m.GetIdentities()))
+
+		for idx, item := range m.GetIdentities() {
+			_, _ = idx, item
+
+			if _, exists := _Config_Identities_Unique[item]; exists {
+				err := ConfigValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "repeated value must contain unique items",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else {
+				_Config_Identities_Unique[item] = struct{}{}
+			}
+
+			// no validation rules for Identities[idx]
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigMultiError is an error wrapping multiple validation errors returned by
+// Config.ValidateAll() if the designated constraints aren't met.
+type ConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigMultiError) AllErrors() []error { return m }
+
+// ConfigValidationError is the validation error returned by Config.Validate if
+// the designated constraints aren't met.
+type ConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigValidationError{}
+
+// Validate checks the field values on ConsumerConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsumerConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsumerConfigMultiError,
+// or nil if none found.
+func (m *ConsumerConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofIdentityPresent := false
+	switch v := m.Identity.(type) {
+	case *ConsumerConfig_Subject:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if utf8.RuneCountInString(m.GetSubject()) < 1 {
+			err := ConsumerConfigValidationError{
+				field:  "Subject",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ConsumerConfig_UriSan:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if utf8.RuneCountInString(m.GetUriSan()) < 1 {
+			err := ConsumerConfigValidationError{
+				field:  "UriSan",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ConsumerConfig_DnsSan:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if utf8.RuneCountInString(m.GetDnsSan()) < 1 {
+			err := ConsumerConfigValidationError{
+				field:  "DnsSan",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ConsumerConfig_Sha256Fingerprint:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if !_ConsumerConfig_Sha256Fingerprint_Pattern.MatchString(m.GetSha256Fingerprint()) {
+			err := ConsumerConfigValidationError{
+				field:  "Sha256Fingerprint",
+				reason: "value does not match regex pattern \"^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofIdentityPresent {
+		err := ConsumerConfigValidationError{
+			field:  "Identity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConsumerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerConfigMultiError is an error wrapping multiple validation errors
+// returned by ConsumerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerConfigMultiError) AllErrors() []error { return m }
+
+// ConsumerConfigValidationError is the validation error returned by
+// ConsumerConfig.Validate if the designated constraints aren't met.
+type ConsumerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerConfigValidationError) ErrorName() string { return "ConsumerConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsumerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerConfigValidationError{}
+
+var _ConsumerConfig_Sha256Fingerprint_Pattern = regexp.MustCompile("^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$")
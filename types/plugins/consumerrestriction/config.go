@@ -15,8 +15,11 @@
 package consumerrestriction
 
 import (
+	"fmt"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
 )
 
 const (
@@ -42,5 +45,49 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func validateRule(r *Rule) error {
+	if _, err := expr.BuildRepeatedStringMatcher(r.Paths); err != nil {
+		return fmt.Errorf("invalid paths: %w", err)
+	}
+	if _, err := expr.BuildRepeatedStringMatcherIgnoreCase(r.Hosts); err != nil {
+		return fmt.Errorf("invalid hosts: %w", err)
+	}
+	for i, h := range r.Headers {
+		if _, err := expr.BuildHeaderMatcher(h); err != nil {
+			return fmt.Errorf("invalid headers[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	var rules []*Rule
+	if conf.GetAllow() != nil {
+		rules = conf.GetAllow().Rules
+	} else if conf.GetDeny() != nil {
+		rules = conf.GetDeny().Rules
+	} else {
+		for _, r := range conf.GetOrdered().GetRules() {
+			rules = append(rules, r.Match)
+		}
+	}
+
+	for i, r := range rules {
+		if err := validateRule(r); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+	return nil
 }
@@ -27,6 +27,8 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
 )
 
 const (
@@ -36,13 +38,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DENY is the default action, so a rule without action doesn't allow the request by accident.
+type Action int32
+
+const (
+	Action_DENY  Action = 0
+	Action_ALLOW Action = 1
+)
+
+// Enum value maps for Action.
+var (
+	Action_name = map[int32]string{
+		0: "DENY",
+		1: "ALLOW",
+	}
+	Action_value = map[string]int32{
+		"DENY":  0,
+		"ALLOW": 1,
+	}
+)
+
+func (x Action) Enum() *Action {
+	p := new(Action)
+	*p = x
+	return p
+}
+
+func (x Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_consumerrestriction_config_proto_enumTypes[0].Descriptor()
+}
+
+func (Action) Type() protoreflect.EnumType {
+	return &file_types_plugins_consumerrestriction_config_proto_enumTypes[0]
+}
+
+func (x Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Action.Descriptor instead.
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{0}
+}
+
+// A rule matches when all the configured conditions match.
 type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The name of the consumer. The rule matches any consumer if it's empty.
 	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Match when the path matches any of the matchers. The path doesn't contain the query string.
+	Paths []*v1.StringMatcher `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Match when the host matches any of the matchers. The match is case-insensitive.
+	Hosts []*v1.StringMatcher `protobuf:"bytes,4,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// Match when all the header matchers match.
+	Headers []*v1.HeaderMatcher `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// The CEL expression which returns a bool.
+	Expr string `protobuf:"bytes,6,opt,name=expr,proto3" json:"expr,omitempty"`
 }
 
 func (x *Rule) Reset() {
@@ -91,6 +150,34 @@ func (x *Rule) GetMethods() []string {
 	return nil
 }
 
+func (x *Rule) GetPaths() []*v1.StringMatcher {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *Rule) GetHosts() []*v1.StringMatcher {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *Rule) GetHeaders() []*v1.HeaderMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Rule) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
 // This message is used to wrap a list of rules because protobuf doesn't support oneof repeated.
 type Rules struct {
 	state         protoimpl.MessageState
@@ -139,23 +226,203 @@ func (x *Rules) GetRules() []*Rule {
 	return nil
 }
 
+type OrderedRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The action when the rule matches. Default to DENY.
+	Action Action `protobuf:"varint,1,opt,name=action,proto3,enum=types.plugins.consumerrestriction.Action" json:"action,omitempty"`
+	Match  *Rule  `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *OrderedRule) Reset() {
+	*x = OrderedRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderedRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderedRule) ProtoMessage() {}
+
+func (x *OrderedRule) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderedRule.ProtoReflect.Descriptor instead.
+func (*OrderedRule) Descriptor() ([]byte, []int) {
+	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderedRule) GetAction() Action {
+	if x != nil {
+		return x.Action
+	}
+	return Action_DENY
+}
+
+func (x *OrderedRule) GetMatch() *Rule {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// The rules are matched in order, and the first matched rule decides the action.
+type OrderedRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*OrderedRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// The action when no rule matches. Default to DENY.
+	DefaultAction Action `protobuf:"varint,2,opt,name=default_action,json=defaultAction,proto3,enum=types.plugins.consumerrestriction.Action" json:"default_action,omitempty"`
+}
+
+func (x *OrderedRules) Reset() {
+	*x = OrderedRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderedRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderedRules) ProtoMessage() {}
+
+func (x *OrderedRules) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderedRules.ProtoReflect.Descriptor instead.
+func (*OrderedRules) Descriptor() ([]byte, []int) {
+	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderedRules) GetRules() []*OrderedRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *OrderedRules) GetDefaultAction() Action {
+	if x != nil {
+		return x.DefaultAction
+	}
+	return Action_DENY
+}
+
+// The response when the request is denied. It also applies to the request without consumer.
+type DenyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default to 403, or 401 if there is no consumer
+	StatusCode v1.StatusCode `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3,enum=types.plugins.api.v1.StatusCode" json:"status_code,omitempty"`
+	// Default to "consumer not allowed", or "consumer not found" if there is no consumer
+	Message string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DenyResponse) Reset() {
+	*x = DenyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyResponse) ProtoMessage() {}
+
+func (x *DenyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyResponse.ProtoReflect.Descriptor instead.
+func (*DenyResponse) Descriptor() ([]byte, []int) {
+	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DenyResponse) GetStatusCode() v1.StatusCode {
+	if x != nil {
+		return x.StatusCode
+	}
+	return v1.StatusCode(0)
+}
+
+func (x *DenyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DenyResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to ConfigType:
-	//
 	//	*Config_Allow
 	//	*Config_Deny
 	//	*Config_DenyIfNoConsumer
-	ConfigType isConfig_ConfigType `protobuf_oneof:"config_type"`
+	//	*Config_Ordered
+	ConfigType   isConfig_ConfigType `protobuf_oneof:"config_type"`
+	DenyResponse *DenyResponse       `protobuf:"bytes,5,opt,name=deny_response,json=denyResponse,proto3" json:"deny_response,omitempty"`
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[2]
+		mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -168,7 +435,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[2]
+	mi := &file_types_plugins_consumerrestriction_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +448,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{2}
+	return file_types_plugins_consumerrestriction_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *Config) GetConfigType() isConfig_ConfigType {
@@ -212,6 +479,20 @@ func (x *Config) GetDenyIfNoConsumer() bool {
 	return false
 }
 
+func (x *Config) GetOrdered() *OrderedRules {
+	if x, ok := x.GetConfigType().(*Config_Ordered); ok {
+		return x.Ordered
+	}
+	return nil
+}
+
+func (x *Config) GetDenyResponse() *DenyResponse {
+	if x != nil {
+		return x.DenyResponse
+	}
+	return nil
+}
+
 type isConfig_ConfigType interface {
 	isConfig_ConfigType()
 }
@@ -228,12 +509,18 @@ type Config_DenyIfNoConsumer struct {
 	DenyIfNoConsumer bool `protobuf:"varint,3,opt,name=deny_if_no_consumer,json=denyIfNoConsumer,proto3,oneof"`
 }
 
+type Config_Ordered struct {
+	Ordered *OrderedRules `protobuf:"bytes,4,opt,name=ordered,proto3,oneof"`
+}
+
 func (*Config_Allow) isConfig_ConfigType() {}
 
 func (*Config_Deny) isConfig_ConfigType() {}
 
 func (*Config_DenyIfNoConsumer) isConfig_ConfigType() {}
 
+func (*Config_Ordered) isConfig_ConfigType() {}
+
 var File_types_plugins_consumerrestriction_config_proto protoreflect.FileDescriptor
 
 var file_types_plugins_consumerrestriction_config_proto_rawDesc = []byte{
@@ -242,35 +529,104 @@ var file_types_plugins_consumerrestriction_config_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x21, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
 	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x04,
-	0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x92, 0x01, 0x0e, 0x22, 0x0c, 0x72, 0x0a, 0x32, 0x08,
-	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x73, 0x22, 0x50, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75,
+	0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x92, 0x01, 0x0e, 0x22, 0x0c,
+	0x72, 0x0a, 0x32, 0x08, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x39, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x02, 0x52, 0x04,
+	0x65, 0x78, 0x70, 0x72, 0x22, 0x50, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x75,
 	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40,
-	0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04, 0x64, 0x65, 0x6e, 0x79,
-	0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x10, 0x64, 0x65, 0x6e, 0x79, 0x49, 0x66, 0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x42, 0x12, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x30, 0x5a, 0x2e, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f,
-	0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x40, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04,
+	0x64, 0x65, 0x6e, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x69, 0x66, 0x5f,
+	0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x79, 0x49, 0x66, 0x4e, 0x6f, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x64, 0x12, 0x54, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65,
+	0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x12, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x2a, 0x1d, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x42, 0x30, 0x5a, 0x2e, 0x6d,
+	0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -285,21 +641,41 @@ func file_types_plugins_consumerrestriction_config_proto_rawDescGZIP() []byte {
 	return file_types_plugins_consumerrestriction_config_proto_rawDescData
 }
 
-var file_types_plugins_consumerrestriction_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_plugins_consumerrestriction_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_plugins_consumerrestriction_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_types_plugins_consumerrestriction_config_proto_goTypes = []interface{}{
-	(*Rule)(nil),   // 0: types.plugins.consumerrestriction.Rule
-	(*Rules)(nil),  // 1: types.plugins.consumerrestriction.Rules
-	(*Config)(nil), // 2: types.plugins.consumerrestriction.Config
+	(Action)(0),              // 0: types.plugins.consumerrestriction.Action
+	(*Rule)(nil),             // 1: types.plugins.consumerrestriction.Rule
+	(*Rules)(nil),            // 2: types.plugins.consumerrestriction.Rules
+	(*OrderedRule)(nil),      // 3: types.plugins.consumerrestriction.OrderedRule
+	(*OrderedRules)(nil),     // 4: types.plugins.consumerrestriction.OrderedRules
+	(*DenyResponse)(nil),     // 5: types.plugins.consumerrestriction.DenyResponse
+	(*Config)(nil),           // 6: types.plugins.consumerrestriction.Config
+	nil,                      // 7: types.plugins.consumerrestriction.DenyResponse.HeadersEntry
+	(*v1.StringMatcher)(nil), // 8: types.plugins.api.v1.StringMatcher
+	(*v1.HeaderMatcher)(nil), // 9: types.plugins.api.v1.HeaderMatcher
+	(v1.StatusCode)(0),       // 10: types.plugins.api.v1.StatusCode
 }
 var file_types_plugins_consumerrestriction_config_proto_depIdxs = []int32{
-	0, // 0: types.plugins.consumerrestriction.Rules.rules:type_name -> types.plugins.consumerrestriction.Rule
-	1, // 1: types.plugins.consumerrestriction.Config.allow:type_name -> types.plugins.consumerrestriction.Rules
-	1, // 2: types.plugins.consumerrestriction.Config.deny:type_name -> types.plugins.consumerrestriction.Rules
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: types.plugins.consumerrestriction.Rule.paths:type_name -> types.plugins.api.v1.StringMatcher
+	8,  // 1: types.plugins.consumerrestriction.Rule.hosts:type_name -> types.plugins.api.v1.StringMatcher
+	9,  // 2: types.plugins.consumerrestriction.Rule.headers:type_name -> types.plugins.api.v1.HeaderMatcher
+	1,  // 3: types.plugins.consumerrestriction.Rules.rules:type_name -> types.plugins.consumerrestriction.Rule
+	0,  // 4: types.plugins.consumerrestriction.OrderedRule.action:type_name -> types.plugins.consumerrestriction.Action
+	1,  // 5: types.plugins.consumerrestriction.OrderedRule.match:type_name -> types.plugins.consumerrestriction.Rule
+	3,  // 6: types.plugins.consumerrestriction.OrderedRules.rules:type_name -> types.plugins.consumerrestriction.OrderedRule
+	0,  // 7: types.plugins.consumerrestriction.OrderedRules.default_action:type_name -> types.plugins.consumerrestriction.Action
+	10, // 8: types.plugins.consumerrestriction.DenyResponse.status_code:type_name -> types.plugins.api.v1.StatusCode
+	7,  // 9: types.plugins.consumerrestriction.DenyResponse.headers:type_name -> types.plugins.consumerrestriction.DenyResponse.HeadersEntry
+	2,  // 10: types.plugins.consumerrestriction.Config.allow:type_name -> types.plugins.consumerrestriction.Rules
+	2,  // 11: types.plugins.consumerrestriction.Config.deny:type_name -> types.plugins.consumerrestriction.Rules
+	4,  // 12: types.plugins.consumerrestriction.Config.ordered:type_name -> types.plugins.consumerrestriction.OrderedRules
+	5,  // 13: types.plugins.consumerrestriction.Config.deny_response:type_name -> types.plugins.consumerrestriction.DenyResponse
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_types_plugins_consumerrestriction_config_proto_init() }
@@ -333,6 +709,42 @@ func file_types_plugins_consumerrestriction_config_proto_init() {
 			}
 		}
 		file_types_plugins_consumerrestriction_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderedRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_consumerrestriction_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderedRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_consumerrestriction_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_consumerrestriction_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -345,23 +757,25 @@ func file_types_plugins_consumerrestriction_config_proto_init() {
 			}
 		}
 	}
-	file_types_plugins_consumerrestriction_config_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_types_plugins_consumerrestriction_config_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Config_Allow)(nil),
 		(*Config_Deny)(nil),
 		(*Config_DenyIfNoConsumer)(nil),
+		(*Config_Ordered)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_plugins_consumerrestriction_config_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_types_plugins_consumerrestriction_config_proto_goTypes,
 		DependencyIndexes: file_types_plugins_consumerrestriction_config_proto_depIdxs,
+		EnumInfos:         file_types_plugins_consumerrestriction_config_proto_enumTypes,
 		MessageInfos:      file_types_plugins_consumerrestriction_config_proto_msgTypes,
 	}.Build()
 	File_types_plugins_consumerrestriction_config_proto = out.File
@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = v1.StatusCode(0)
 )
 
 // Validate checks the field values on Rule with the rules defined in the proto
@@ -56,16 +60,7 @@ func (m *Rule) validate(all bool) error {
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := RuleValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
 	for idx, item := range m.GetMethods() {
 		_, _ = idx, item
@@ -83,6 +78,110 @@ func (m *Rule) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleValidationError{
+					field:  fmt.Sprintf("Hosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleValidationError{
+					field:  fmt.Sprintf("Headers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Expr
+
 	if len(errors) > 0 {
 		return RuleMultiError(errors)
 	}
@@ -305,6 +404,398 @@ var _ interface {
 	ErrorName() string
 } = RulesValidationError{}
 
+// Validate checks the field values on OrderedRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderedRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderedRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderedRuleMultiError, or
+// nil if none found.
+func (m *OrderedRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderedRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	if m.GetMatch() == nil {
+		err := OrderedRuleValidationError{
+			field:  "Match",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderedRuleValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderedRuleValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderedRuleValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderedRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderedRuleMultiError is an error wrapping multiple validation errors
+// returned by OrderedRule.ValidateAll() if the designated constraints aren't met.
+type OrderedRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderedRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderedRuleMultiError) AllErrors() []error { return m }
+
+// OrderedRuleValidationError is the validation error returned by
+// OrderedRule.Validate if the designated constraints aren't met.
+type OrderedRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderedRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderedRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderedRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderedRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderedRuleValidationError) ErrorName() string { return "OrderedRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderedRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderedRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderedRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderedRuleValidationError{}
+
+// Validate checks the field values on OrderedRules with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderedRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderedRules with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderedRulesMultiError, or
+// nil if none found.
+func (m *OrderedRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderedRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRules()) < 1 {
+		err := OrderedRulesValidationError{
+			field:  "Rules",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderedRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderedRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderedRulesValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DefaultAction
+
+	if len(errors) > 0 {
+		return OrderedRulesMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderedRulesMultiError is an error wrapping multiple validation errors
+// returned by OrderedRules.ValidateAll() if the designated constraints aren't met.
+type OrderedRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderedRulesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderedRulesMultiError) AllErrors() []error { return m }
+
+// OrderedRulesValidationError is the validation error returned by
+// OrderedRules.Validate if the designated constraints aren't met.
+type OrderedRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderedRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderedRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderedRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderedRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderedRulesValidationError) ErrorName() string { return "OrderedRulesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderedRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderedRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderedRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderedRulesValidationError{}
+
+// Validate checks the field values on DenyResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DenyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DenyResponseMultiError, or
+// nil if none found.
+func (m *DenyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	// no validation rules for Headers
+
+	if len(errors) > 0 {
+		return DenyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyResponseMultiError is an error wrapping multiple validation errors
+// returned by DenyResponse.ValidateAll() if the designated constraints aren't met.
+type DenyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyResponseMultiError) AllErrors() []error { return m }
+
+// DenyResponseValidationError is the validation error returned by
+// DenyResponse.Validate if the designated constraints aren't met.
+type DenyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyResponseValidationError) ErrorName() string { return "DenyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DenyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyResponseValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -326,6 +817,35 @@ func (m *Config) validate(all bool) error {
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetDenyResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DenyResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DenyResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDenyResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "DenyResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	oneofConfigTypePresent := false
 	switch v := m.ConfigType.(type) {
 	case *Config_Allow:
@@ -425,6 +945,48 @@ func (m *Config) validate(all bool) error {
 		}
 		oneofConfigTypePresent = true
 		// no validation rules for DenyIfNoConsumer
+	case *Config_Ordered:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "ConfigType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofConfigTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetOrdered()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Ordered",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Ordered",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrdered()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Ordered",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
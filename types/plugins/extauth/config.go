@@ -15,6 +15,10 @@
 package extauth
 
 import (
+	"errors"
+	"fmt"
+	"net"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 )
@@ -44,5 +48,29 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if gs := conf.GetGrpcService(); gs != nil {
+		if _, _, err := net.SplitHostPort(gs.Address); err != nil {
+			return fmt.Errorf("bad address %s: %w", gs.Address, err)
+		}
+	}
+
+	if cache := conf.GetHttpService().GetCache(); cache != nil {
+		if cache.Ttl != nil && cache.MaxTtl != nil && cache.Ttl.AsDuration() > cache.MaxTtl.AsDuration() {
+			return errors.New("cache ttl should not be greater than max_ttl")
+		}
+	}
+	return nil
 }
@@ -15,6 +15,8 @@
 package hmacauth
 
 import (
+	"errors"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 )
@@ -42,7 +44,27 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if conf.Nonce != nil && conf.ClockSkew == nil {
+		return errors.New("clock_skew is required when nonce is configured")
+	}
+	return nil
+}
+
+func (conf *CustomConfig) AuthenticateRequestBody() bool {
+	return conf.BodyDigest != nil
 }
 
 func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
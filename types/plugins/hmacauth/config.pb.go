@@ -27,6 +27,9 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
 )
 
 const (
@@ -36,6 +39,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Canonicalization int32
+
+const (
+	// The signing scheme of Apache APISIX.
+	Canonicalization_APISIX Canonicalization = 0
+	// Sorted and encoded query parameters, lowercased and sorted signed headers.
+	Canonicalization_CANONICAL Canonicalization = 1
+)
+
+// Enum value maps for Canonicalization.
+var (
+	Canonicalization_name = map[int32]string{
+		0: "APISIX",
+		1: "CANONICAL",
+	}
+	Canonicalization_value = map[string]int32{
+		"APISIX":    0,
+		"CANONICAL": 1,
+	}
+)
+
+func (x Canonicalization) Enum() *Canonicalization {
+	p := new(Canonicalization)
+	*p = x
+	return p
+}
+
+func (x Canonicalization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Canonicalization) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_hmacauth_config_proto_enumTypes[0].Descriptor()
+}
+
+func (Canonicalization) Type() protoreflect.EnumType {
+	return &file_types_plugins_hmacauth_config_proto_enumTypes[0]
+}
+
+func (x Canonicalization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Canonicalization.Descriptor instead.
+func (Canonicalization) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{0}
+}
+
 type Algorithm int32
 
 const (
@@ -69,11 +120,11 @@ func (x Algorithm) String() string {
 }
 
 func (Algorithm) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_plugins_hmacauth_config_proto_enumTypes[0].Descriptor()
+	return file_types_plugins_hmacauth_config_proto_enumTypes[1].Descriptor()
 }
 
 func (Algorithm) Type() protoreflect.EnumType {
-	return &file_types_plugins_hmacauth_config_proto_enumTypes[0]
+	return &file_types_plugins_hmacauth_config_proto_enumTypes[1]
 }
 
 func (x Algorithm) Number() protoreflect.EnumNumber {
@@ -82,7 +133,7 @@ func (x Algorithm) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Algorithm.Descriptor instead.
 func (Algorithm) EnumDescriptor() ([]byte, []int) {
-	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{0}
+	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{1}
 }
 
 type Config struct {
@@ -93,6 +144,16 @@ type Config struct {
 	SignatureHeader string `protobuf:"bytes,1,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
 	AccessKeyHeader string `protobuf:"bytes,2,opt,name=access_key_header,json=accessKeyHeader,proto3" json:"access_key_header,omitempty"`
 	DateHeader      string `protobuf:"bytes,3,opt,name=date_header,json=dateHeader,proto3" json:"date_header,omitempty"`
+	// The maximum allowed difference between the date in the request and the clock of the gateway.
+	// When this is set, requests without a valid date or with a date out of the window are rejected.
+	ClockSkew *durationpb.Duration `protobuf:"bytes,4,opt,name=clock_skew,json=clockSkew,proto3" json:"clock_skew,omitempty"`
+	// Reject the replayed requests according to the nonce. ``clock_skew`` is required when this
+	// is set.
+	Nonce *Nonce `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Verify the digest of the request body and include the digest in the signature.
+	BodyDigest *BodyDigest `protobuf:"bytes,6,opt,name=body_digest,json=bodyDigest,proto3" json:"body_digest,omitempty"`
+	// How to build the content to sign. Default to APISIX.
+	Canonicalization Canonicalization `protobuf:"varint,7,opt,name=canonicalization,proto3,enum=types.plugins.hmacauth.Canonicalization" json:"canonicalization,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -148,6 +209,142 @@ func (x *Config) GetDateHeader() string {
 	return ""
 }
 
+func (x *Config) GetClockSkew() *durationpb.Duration {
+	if x != nil {
+		return x.ClockSkew
+	}
+	return nil
+}
+
+func (x *Config) GetNonce() *Nonce {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *Config) GetBodyDigest() *BodyDigest {
+	if x != nil {
+		return x.BodyDigest
+	}
+	return nil
+}
+
+func (x *Config) GetCanonicalization() Canonicalization {
+	if x != nil {
+		return x.Canonicalization
+	}
+	return Canonicalization_APISIX
+}
+
+type BodyDigest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The header which contains the digest of the request body. Both ``Content-Digest`` (RFC 9530)
+	// and ``Digest`` (RFC 3230) formats are supported. Default to ``content-digest``.
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *BodyDigest) Reset() {
+	*x = BodyDigest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_hmacauth_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BodyDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyDigest) ProtoMessage() {}
+
+func (x *BodyDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_hmacauth_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyDigest.ProtoReflect.Descriptor instead.
+func (*BodyDigest) Descriptor() ([]byte, []int) {
+	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BodyDigest) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+type Nonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The header which contains the nonce. Default to ``x-hmac-nonce``.
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Store the nonces in Redis so that the replay protection works across gateway replicas.
+	// The nonces are stored in memory by default.
+	// The keys in Redis are prefixed with ``htnn_hmac_nonce`` by default.
+	Redis *v1.Redis `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+}
+
+func (x *Nonce) Reset() {
+	*x = Nonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_hmacauth_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nonce) ProtoMessage() {}
+
+func (x *Nonce) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_hmacauth_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nonce.ProtoReflect.Descriptor instead.
+func (*Nonce) Descriptor() ([]byte, []int) {
+	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Nonce) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Nonce) GetRedis() *v1.Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
 type ConsumerConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,7 +360,7 @@ type ConsumerConfig struct {
 func (x *ConsumerConfig) Reset() {
 	*x = ConsumerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_plugins_hmacauth_config_proto_msgTypes[1]
+		mi := &file_types_plugins_hmacauth_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -176,7 +373,7 @@ func (x *ConsumerConfig) String() string {
 func (*ConsumerConfig) ProtoMessage() {}
 
 func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_hmacauth_config_proto_msgTypes[1]
+	mi := &file_types_plugins_hmacauth_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,7 +386,7 @@ func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumerConfig.ProtoReflect.Descriptor instead.
 func (*ConsumerConfig) Descriptor() ([]byte, []int) {
-	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{1}
+	return file_types_plugins_hmacauth_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConsumerConfig) GetAccessKey() string {
@@ -226,17 +423,46 @@ var file_types_plugins_hmacauth_config_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
 	0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x17, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x20, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x64, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa,
+	0x01, 0x02, 0x2a, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x12,
+	0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x68,
+	0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x68, 0x6d, 0x61, 0x63, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x62,
+	0x6f, 0x64, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x24, 0x0a, 0x0a, 0x42, 0x6f, 0x64, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64,
+	0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
 	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0a,
 	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73,
@@ -250,14 +476,16 @@ var file_types_plugins_hmacauth_config_proto_rawDesc = []byte{
 	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
 	0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72,
 	0x02, 0x10, 0x01, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x2a, 0x3e, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
-	0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32,
-	0x10, 0x02, 0x42, 0x25, 0x5a, 0x23, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74,
-	0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
-	0x2f, 0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x73, 0x2a, 0x2d, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x53, 0x49, 0x58,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x4f, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x10,
+	0x01, 0x2a, 0x3e, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10,
+	0x02, 0x42, 0x25, 0x5a, 0x23, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e,
+	0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x68, 0x6d, 0x61, 0x63, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -272,20 +500,30 @@ func file_types_plugins_hmacauth_config_proto_rawDescGZIP() []byte {
 	return file_types_plugins_hmacauth_config_proto_rawDescData
 }
 
-var file_types_plugins_hmacauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_types_plugins_hmacauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_types_plugins_hmacauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_types_plugins_hmacauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_types_plugins_hmacauth_config_proto_goTypes = []interface{}{
-	(Algorithm)(0),         // 0: types.plugins.hmacauth.Algorithm
-	(*Config)(nil),         // 1: types.plugins.hmacauth.Config
-	(*ConsumerConfig)(nil), // 2: types.plugins.hmacauth.ConsumerConfig
+	(Canonicalization)(0),       // 0: types.plugins.hmacauth.Canonicalization
+	(Algorithm)(0),              // 1: types.plugins.hmacauth.Algorithm
+	(*Config)(nil),              // 2: types.plugins.hmacauth.Config
+	(*BodyDigest)(nil),          // 3: types.plugins.hmacauth.BodyDigest
+	(*Nonce)(nil),               // 4: types.plugins.hmacauth.Nonce
+	(*ConsumerConfig)(nil),      // 5: types.plugins.hmacauth.ConsumerConfig
+	(*durationpb.Duration)(nil), // 6: google.protobuf.Duration
+	(*v1.Redis)(nil),            // 7: types.plugins.api.v1.Redis
 }
 var file_types_plugins_hmacauth_config_proto_depIdxs = []int32{
-	0, // 0: types.plugins.hmacauth.ConsumerConfig.algorithm:type_name -> types.plugins.hmacauth.Algorithm
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: types.plugins.hmacauth.Config.clock_skew:type_name -> google.protobuf.Duration
+	4, // 1: types.plugins.hmacauth.Config.nonce:type_name -> types.plugins.hmacauth.Nonce
+	3, // 2: types.plugins.hmacauth.Config.body_digest:type_name -> types.plugins.hmacauth.BodyDigest
+	0, // 3: types.plugins.hmacauth.Config.canonicalization:type_name -> types.plugins.hmacauth.Canonicalization
+	7, // 4: types.plugins.hmacauth.Nonce.redis:type_name -> types.plugins.api.v1.Redis
+	1, // 5: types.plugins.hmacauth.ConsumerConfig.algorithm:type_name -> types.plugins.hmacauth.Algorithm
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_types_plugins_hmacauth_config_proto_init() }
@@ -307,6 +545,30 @@ func file_types_plugins_hmacauth_config_proto_init() {
 			}
 		}
 		file_types_plugins_hmacauth_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BodyDigest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_hmacauth_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_hmacauth_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumerConfig); i {
 			case 0:
 				return &v.state
@@ -324,8 +586,8 @@ func file_types_plugins_hmacauth_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_plugins_hmacauth_config_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -62,6 +62,96 @@ func (m *Config) validate(all bool) error {
 
 	// no validation rules for DateHeader
 
+	if d := m.GetClockSkew(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ConfigValidationError{
+				field:  "ClockSkew",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ConfigValidationError{
+					field:  "ClockSkew",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNonce()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Nonce",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Nonce",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNonce()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Nonce",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBodyDigest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BodyDigest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BodyDigest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBodyDigest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "BodyDigest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Canonicalization
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -139,6 +229,236 @@ var _ interface {
 	ErrorName() string
 } = ConfigValidationError{}
 
+// Validate checks the field values on BodyDigest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BodyDigest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BodyDigest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BodyDigestMultiError, or
+// nil if none found.
+func (m *BodyDigest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BodyDigest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Header
+
+	if len(errors) > 0 {
+		return BodyDigestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BodyDigestMultiError is an error wrapping multiple validation errors
+// returned by BodyDigest.ValidateAll() if the designated constraints aren't met.
+type BodyDigestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BodyDigestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BodyDigestMultiError) AllErrors() []error { return m }
+
+// BodyDigestValidationError is the validation error returned by
+// BodyDigest.Validate if the designated constraints aren't met.
+type BodyDigestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BodyDigestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BodyDigestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BodyDigestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BodyDigestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BodyDigestValidationError) ErrorName() string { return "BodyDigestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BodyDigestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBodyDigest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BodyDigestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BodyDigestValidationError{}
+
+// Validate checks the field values on Nonce with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Nonce) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Nonce with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NonceMultiError, or nil if none found.
+func (m *Nonce) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Nonce) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Header
+
+	if all {
+		switch v := interface{}(m.GetRedis()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NonceValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NonceValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedis()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NonceValidationError{
+				field:  "Redis",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NonceMultiError(errors)
+	}
+
+	return nil
+}
+
+// NonceMultiError is an error wrapping multiple validation errors returned by
+// Nonce.ValidateAll() if the designated constraints aren't met.
+type NonceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NonceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NonceMultiError) AllErrors() []error { return m }
+
+// NonceValidationError is the validation error returned by Nonce.Validate if
+// the designated constraints aren't met.
+type NonceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NonceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NonceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NonceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NonceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NonceValidationError) ErrorName() string { return "NonceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NonceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNonce.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NonceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NonceValidationError{}
+
 // Validate checks the field values on ConsumerConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
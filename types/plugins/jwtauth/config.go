@@ -0,0 +1,89 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+)
+
+const (
+	Name = "jwtAuth"
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &CustomConfig{}
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &ConsumerConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if conf.Issuer == "" && !conf.AllowAnyIssuer {
+		return errors.New("issuer is required unless allow_any_issuer is set")
+	}
+	if len(conf.Audiences) == 0 && !conf.AllowAnyAudience {
+		return errors.New("audiences is required unless allow_any_audience is set")
+	}
+
+	if jwks := conf.GetLocalJwks(); jwks != "" {
+		var set struct {
+			Keys []json.RawMessage `json:"keys"`
+		}
+		if err := json.Unmarshal([]byte(jwks), &set); err != nil {
+			return fmt.Errorf("invalid local_jwks: %w", err)
+		}
+		if len(set.Keys) == 0 {
+			return errors.New("invalid local_jwks: no key")
+		}
+	}
+	return nil
+}
+
+func (conf *ConsumerConfig) Index() string {
+	return conf.ClaimValue
+}
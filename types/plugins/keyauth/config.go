@@ -50,5 +50,8 @@ func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
 }
 
 func (conf *ConsumerConfig) Index() string {
-	return conf.Key
+	if hk := conf.GetHashedKey(); hk != nil {
+		return hk.Id
+	}
+	return conf.GetKey()
 }
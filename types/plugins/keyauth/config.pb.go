@@ -82,6 +82,52 @@ func (Source) EnumDescriptor() ([]byte, []int) {
 	return file_types_plugins_keyauth_config_proto_rawDescGZIP(), []int{0}
 }
 
+type HashAlgorithm int32
+
+const (
+	HashAlgorithm_SHA256   HashAlgorithm = 0
+	HashAlgorithm_ARGON2ID HashAlgorithm = 1
+)
+
+// Enum value maps for HashAlgorithm.
+var (
+	HashAlgorithm_name = map[int32]string{
+		0: "SHA256",
+		1: "ARGON2ID",
+	}
+	HashAlgorithm_value = map[string]int32{
+		"SHA256":   0,
+		"ARGON2ID": 1,
+	}
+)
+
+func (x HashAlgorithm) Enum() *HashAlgorithm {
+	p := new(HashAlgorithm)
+	*p = x
+	return p
+}
+
+func (x HashAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HashAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_keyauth_config_proto_enumTypes[1].Descriptor()
+}
+
+func (HashAlgorithm) Type() protoreflect.EnumType {
+	return &file_types_plugins_keyauth_config_proto_enumTypes[1]
+}
+
+func (x HashAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HashAlgorithm.Descriptor instead.
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_keyauth_config_proto_rawDescGZIP(), []int{1}
+}
+
 type Key struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -190,7 +236,10 @@ type ConsumerConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to KeySpecifier:
+	//	*ConsumerConfig_Key
+	//	*ConsumerConfig_HashedKey
+	KeySpecifier isConsumerConfig_KeySpecifier `protobuf_oneof:"key_specifier"`
 }
 
 func (x *ConsumerConfig) Reset() {
@@ -225,13 +274,198 @@ func (*ConsumerConfig) Descriptor() ([]byte, []int) {
 	return file_types_plugins_keyauth_config_proto_rawDescGZIP(), []int{2}
 }
 
+func (m *ConsumerConfig) GetKeySpecifier() isConsumerConfig_KeySpecifier {
+	if m != nil {
+		return m.KeySpecifier
+	}
+	return nil
+}
+
 func (x *ConsumerConfig) GetKey() string {
-	if x != nil {
+	if x, ok := x.GetKeySpecifier().(*ConsumerConfig_Key); ok {
 		return x.Key
 	}
 	return ""
 }
 
+func (x *ConsumerConfig) GetHashedKey() *HashedKey {
+	if x, ok := x.GetKeySpecifier().(*ConsumerConfig_HashedKey); ok {
+		return x.HashedKey
+	}
+	return nil
+}
+
+type isConsumerConfig_KeySpecifier interface {
+	isConsumerConfig_KeySpecifier()
+}
+
+type ConsumerConfig_Key struct {
+	// The plaintext key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3,oneof"`
+}
+
+type ConsumerConfig_HashedKey struct {
+	// The hashed key. The client should send the key in the format ``<id>.<secret>``.
+	HashedKey *HashedKey `protobuf:"bytes,2,opt,name=hashed_key,json=hashedKey,proto3,oneof"`
+}
+
+func (*ConsumerConfig_Key) isConsumerConfig_KeySpecifier() {}
+
+func (*ConsumerConfig_HashedKey) isConsumerConfig_KeySpecifier() {}
+
+// The parameters are bounded by the default values, as each request costs the configured memory
+// and time to verify the key.
+type Argon2Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of passes over the memory. Default to 3.
+	Time uint32 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The size of the memory in KiB. Default to 65536.
+	Memory uint32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// The number of threads. Default to 4.
+	Threads uint32 `protobuf:"varint,3,opt,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *Argon2Params) Reset() {
+	*x = Argon2Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_keyauth_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Argon2Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Argon2Params) ProtoMessage() {}
+
+func (x *Argon2Params) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_keyauth_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Argon2Params.ProtoReflect.Descriptor instead.
+func (*Argon2Params) Descriptor() ([]byte, []int) {
+	return file_types_plugins_keyauth_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Argon2Params) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Argon2Params) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Argon2Params) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+type HashedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public ID of the key. It is used to find the consumer.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The algorithm to hash the secret part of the key. Default to SHA256.
+	Algorithm HashAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=types.plugins.keyauth.HashAlgorithm" json:"algorithm,omitempty"`
+	// The base64 encoded salt.
+	Salt string `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// The base64 encoded hash of the secret part of the key. When the algorithm is SHA256,
+	// it's the hash of the salt followed by the secret.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The parameters used when the algorithm is ARGON2ID.
+	Argon2 *Argon2Params `protobuf:"bytes,5,opt,name=argon2,proto3" json:"argon2,omitempty"`
+}
+
+func (x *HashedKey) Reset() {
+	*x = HashedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_keyauth_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashedKey) ProtoMessage() {}
+
+func (x *HashedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_keyauth_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashedKey.ProtoReflect.Descriptor instead.
+func (*HashedKey) Descriptor() ([]byte, []int) {
+	return file_types_plugins_keyauth_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HashedKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HashedKey) GetAlgorithm() HashAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return HashAlgorithm_SHA256
+}
+
+func (x *HashedKey) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *HashedKey) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *HashedKey) GetArgon2() *Argon2Params {
+	if x != nil {
+		return x.Argon2
+	}
+	return nil
+}
+
 var File_types_plugins_keyauth_config_proto protoreflect.FileDescriptor
 
 var file_types_plugins_keyauth_config_proto_rawDesc = []byte{
@@ -250,14 +484,48 @@ var file_types_plugins_keyauth_config_proto_rawDesc = []byte{
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68, 0x2e,
 	0x4b, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x22, 0x2b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x2a, 0x1f, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45,
-	0x41, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10,
-	0x01, 0x42, 0x24, 0x5a, 0x22, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e,
-	0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
-	0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x79, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x09, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x42, 0x14, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x77, 0x0a, 0x0c,
+	0x41, 0x72, 0x67, 0x6f, 0x6e, 0x32, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a,
+	0x04, 0x18, 0x03, 0x40, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x2a, 0x06, 0x18, 0x80, 0x80, 0x04, 0x40, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x12, 0x23, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x04, 0x40, 0x01, 0x52, 0x07, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xfa, 0x42, 0x0b, 0x72, 0x09, 0x32, 0x07, 0x5e, 0x5b, 0x5e, 0x2e, 0x5d, 0x2b, 0x24, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x10, 0x01, 0x32, 0x16,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2b, 0x2f, 0x5d, 0x2b, 0x3d,
+	0x7b, 0x30, 0x2c, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72,
+	0x1a, 0x10, 0x01, 0x32, 0x16, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x2b, 0x2f, 0x5d, 0x2b, 0x3d, 0x7b, 0x30, 0x2c, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x72, 0x67, 0x6f, 0x6e, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x6e, 0x32,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x61, 0x72, 0x67, 0x6f, 0x6e, 0x32, 0x2a, 0x1f,
+	0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41, 0x44,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x2a,
+	0x29, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x52, 0x47, 0x4f, 0x4e, 0x32, 0x49, 0x44, 0x10, 0x01, 0x42, 0x24, 0x5a, 0x22, 0x6d, 0x6f,
+	0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x61, 0x75, 0x74, 0x68,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -272,22 +540,28 @@ func file_types_plugins_keyauth_config_proto_rawDescGZIP() []byte {
 	return file_types_plugins_keyauth_config_proto_rawDescData
 }
 
-var file_types_plugins_keyauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_types_plugins_keyauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_plugins_keyauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_types_plugins_keyauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_types_plugins_keyauth_config_proto_goTypes = []interface{}{
 	(Source)(0),            // 0: types.plugins.keyauth.Source
-	(*Key)(nil),            // 1: types.plugins.keyauth.Key
-	(*Config)(nil),         // 2: types.plugins.keyauth.Config
-	(*ConsumerConfig)(nil), // 3: types.plugins.keyauth.ConsumerConfig
+	(HashAlgorithm)(0),     // 1: types.plugins.keyauth.HashAlgorithm
+	(*Key)(nil),            // 2: types.plugins.keyauth.Key
+	(*Config)(nil),         // 3: types.plugins.keyauth.Config
+	(*ConsumerConfig)(nil), // 4: types.plugins.keyauth.ConsumerConfig
+	(*Argon2Params)(nil),   // 5: types.plugins.keyauth.Argon2Params
+	(*HashedKey)(nil),      // 6: types.plugins.keyauth.HashedKey
 }
 var file_types_plugins_keyauth_config_proto_depIdxs = []int32{
 	0, // 0: types.plugins.keyauth.Key.source:type_name -> types.plugins.keyauth.Source
-	1, // 1: types.plugins.keyauth.Config.keys:type_name -> types.plugins.keyauth.Key
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: types.plugins.keyauth.Config.keys:type_name -> types.plugins.keyauth.Key
+	6, // 2: types.plugins.keyauth.ConsumerConfig.hashed_key:type_name -> types.plugins.keyauth.HashedKey
+	1, // 3: types.plugins.keyauth.HashedKey.algorithm:type_name -> types.plugins.keyauth.HashAlgorithm
+	5, // 4: types.plugins.keyauth.HashedKey.argon2:type_name -> types.plugins.keyauth.Argon2Params
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_types_plugins_keyauth_config_proto_init() }
@@ -332,14 +606,42 @@ func file_types_plugins_keyauth_config_proto_init() {
 				return nil
 			}
 		}
+		file_types_plugins_keyauth_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Argon2Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_keyauth_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_keyauth_config_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ConsumerConfig_Key)(nil),
+		(*ConsumerConfig_HashedKey)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_plugins_keyauth_config_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
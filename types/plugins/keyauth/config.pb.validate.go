@@ -311,10 +311,81 @@ func (m *ConsumerConfig) validate(all bool) error {
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetKey()) < 1 {
+	oneofKeySpecifierPresent := false
+	switch v := m.KeySpecifier.(type) {
+	case *ConsumerConfig_Key:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "KeySpecifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeySpecifierPresent = true
+
+		if utf8.RuneCountInString(m.GetKey()) < 1 {
+			err := ConsumerConfigValidationError{
+				field:  "Key",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ConsumerConfig_HashedKey:
+		if v == nil {
+			err := ConsumerConfigValidationError{
+				field:  "KeySpecifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeySpecifierPresent = true
+
+		if all {
+			switch v := interface{}(m.GetHashedKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConsumerConfigValidationError{
+						field:  "HashedKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConsumerConfigValidationError{
+						field:  "HashedKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHashedKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConsumerConfigValidationError{
+					field:  "HashedKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeySpecifierPresent {
 		err := ConsumerConfigValidationError{
-			field:  "Key",
-			reason: "value length must be at least 1 runes",
+			field:  "KeySpecifier",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -399,3 +470,338 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConsumerConfigValidationError{}
+
+// Validate checks the field values on Argon2Params with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Argon2Params) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Argon2Params with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Argon2ParamsMultiError, or
+// nil if none found.
+func (m *Argon2Params) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Argon2Params) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTime() != 0 {
+
+		if m.GetTime() > 3 {
+			err := Argon2ParamsValidationError{
+				field:  "Time",
+				reason: "value must be less than or equal to 3",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetMemory() != 0 {
+
+		if m.GetMemory() > 65536 {
+			err := Argon2ParamsValidationError{
+				field:  "Memory",
+				reason: "value must be less than or equal to 65536",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetThreads() != 0 {
+
+		if m.GetThreads() > 4 {
+			err := Argon2ParamsValidationError{
+				field:  "Threads",
+				reason: "value must be less than or equal to 4",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return Argon2ParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// Argon2ParamsMultiError is an error wrapping multiple validation errors
+// returned by Argon2Params.ValidateAll() if the designated constraints aren't met.
+type Argon2ParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Argon2ParamsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Argon2ParamsMultiError) AllErrors() []error { return m }
+
+// Argon2ParamsValidationError is the validation error returned by
+// Argon2Params.Validate if the designated constraints aren't met.
+type Argon2ParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Argon2ParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Argon2ParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Argon2ParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Argon2ParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Argon2ParamsValidationError) ErrorName() string { return "Argon2ParamsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Argon2ParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArgon2Params.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Argon2ParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Argon2ParamsValidationError{}
+
+// Validate checks the field values on HashedKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HashedKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HashedKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HashedKeyMultiError, or nil
+// if none found.
+func (m *HashedKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HashedKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_HashedKey_Id_Pattern.MatchString(m.GetId()) {
+		err := HashedKeyValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[^.]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Algorithm
+
+	if utf8.RuneCountInString(m.GetSalt()) < 1 {
+		err := HashedKeyValidationError{
+			field:  "Salt",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_HashedKey_Salt_Pattern.MatchString(m.GetSalt()) {
+		err := HashedKeyValidationError{
+			field:  "Salt",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9+/]+={0,2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHash()) < 1 {
+		err := HashedKeyValidationError{
+			field:  "Hash",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_HashedKey_Hash_Pattern.MatchString(m.GetHash()) {
+		err := HashedKeyValidationError{
+			field:  "Hash",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9+/]+={0,2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetArgon2()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HashedKeyValidationError{
+					field:  "Argon2",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HashedKeyValidationError{
+					field:  "Argon2",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArgon2()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HashedKeyValidationError{
+				field:  "Argon2",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HashedKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HashedKeyMultiError is an error wrapping multiple validation errors returned
+// by HashedKey.ValidateAll() if the designated constraints aren't met.
+type HashedKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HashedKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HashedKeyMultiError) AllErrors() []error { return m }
+
+// HashedKeyValidationError is the validation error returned by
+// HashedKey.Validate if the designated constraints aren't met.
+type HashedKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HashedKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HashedKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HashedKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HashedKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HashedKeyValidationError) ErrorName() string { return "HashedKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HashedKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHashedKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HashedKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HashedKeyValidationError{}
+
+var _HashedKey_Id_Pattern = regexp.MustCompile("^[^.]+$")
+
+var _HashedKey_Salt_Pattern = regexp.MustCompile("^[A-Za-z0-9+/]+={0,2}$")
+
+var _HashedKey_Hash_Pattern = regexp.MustCompile("^[A-Za-z0-9+/]+={0,2}$")
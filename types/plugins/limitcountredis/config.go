@@ -18,11 +18,8 @@ import (
 	"fmt"
 	"net"
 
-	"github.com/google/cel-go/cel"
-
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
-	"mosn.io/htnn/types/pkg/expr"
 )
 
 const (
@@ -83,16 +80,6 @@ func (conf *CustomConfig) Validate() error {
 		}
 	}
 
-	for i, rule := range conf.Rules {
-		if rule.Key == "" {
-			continue
-		}
-		_, err = expr.CompileCel(rule.Key, cel.StringType)
-		if err != nil {
-			return fmt.Errorf("bad rule %d: %w", i, err)
-		}
-	}
-
 	if conf.Username != "" && conf.Password == "" {
 		return fmt.Errorf("password is required when username is set")
 	}
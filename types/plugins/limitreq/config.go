@@ -15,11 +15,8 @@
 package limitreq
 
 import (
-	"github.com/google/cel-go/cel"
-
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
-	"mosn.io/htnn/types/pkg/expr"
 )
 
 const (
@@ -45,24 +42,5 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &CustomConfig{}
-}
-
-type CustomConfig struct {
-	Config
-}
-
-func (conf *CustomConfig) Validate() error {
-	err := conf.Config.Validate()
-	if err != nil {
-		return err
-	}
-
-	if conf.Key != "" {
-		_, err = expr.CompileCel(conf.Key, cel.StringType)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return &Config{}
 }
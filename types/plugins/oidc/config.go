@@ -15,6 +15,8 @@
 package oidc
 
 import (
+	"errors"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 )
@@ -46,5 +48,50 @@ func (p *Plugin) NonBlockingPhases() api.Phase {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if c := conf.Cookie; c != nil && c.SameSite == SameSite_NONE && !c.Secure {
+		return errors.New("cookie with SameSite=None must be secure")
+	}
+
+	if conf.Mode == Mode_BEARER {
+		bearer := conf.GetBearer()
+		// The ID tokens, and the access tokens issued for other APIs, are signed by the same provider.
+		// The introspection endpoint also reports them as active. So the audiences of the access
+		// tokens are required to tell them apart.
+		if len(bearer.GetAudiences()) == 0 {
+			return errors.New("bearer.audiences is required to verify the access token")
+		}
+		if bearer.GetIntrospection() && conf.ClientSecret == "" {
+			return errors.New("client_secret is required to use token introspection")
+		}
+		return nil
+	}
+
+	if conf.RedirectUrl == "" {
+		return errors.New("redirect_url is required in AUTHORIZATION_CODE mode")
+	}
+	if conf.ClientSecret == "" && conf.CookieSecret == "" {
+		return errors.New("cookie_secret is required when client_secret is not set")
+	}
+	return nil
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &ConsumerConfig{}
+}
+
+func (conf *ConsumerConfig) Index() string {
+	return conf.ClaimValue
 }
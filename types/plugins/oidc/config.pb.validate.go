@@ -67,16 +67,7 @@ func (m *Config) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetClientSecret()) < 1 {
-		err := ConfigValidationError{
-			field:  "ClientSecret",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ClientSecret
 
 	if uri, err := url.Parse(m.GetIssuer()); err != nil {
 		err = ConfigValidationError{
@@ -99,25 +90,29 @@ func (m *Config) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
-		err = ConfigValidationError{
-			field:  "RedirectUrl",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := ConfigValidationError{
-			field:  "RedirectUrl",
-			reason: "value must be absolute",
-		}
-		if !all {
-			return err
+	if m.GetRedirectUrl() != "" {
+
+		if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
+			err = ConfigValidationError{
+				field:  "RedirectUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := ConfigValidationError{
+				field:  "RedirectUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	// no validation rules for SkipNonceVerify
@@ -186,6 +181,175 @@ func (m *Config) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetCookie()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Cookie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Cookie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCookie()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Cookie",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLogout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Logout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Logout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLogout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Logout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Mode
+
+	if all {
+		switch v := interface{}(m.GetBearer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Bearer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Bearer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBearer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Bearer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetClaimsToHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ConsumerClaim
+
+	if m.GetCookieSecret() != "" {
+
+		if utf8.RuneCountInString(m.GetCookieSecret()) < 16 {
+			err := ConfigValidationError{
+				field:  "CookieSecret",
+				reason: "value length must be at least 16 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -262,3 +426,592 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConfigValidationError{}
+
+// Validate checks the field values on Bearer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Bearer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bearer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BearerMultiError, or nil if none found.
+func (m *Bearer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bearer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Introspection
+
+	if len(m.GetAudiences()) < 1 {
+		err := BearerValidationError{
+			field:  "Audiences",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BearerMultiError(errors)
+	}
+
+	return nil
+}
+
+// BearerMultiError is an error wrapping multiple validation errors returned by
+// Bearer.ValidateAll() if the designated constraints aren't met.
+type BearerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BearerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BearerMultiError) AllErrors() []error { return m }
+
+// BearerValidationError is the validation error returned by Bearer.Validate if
+// the designated constraints aren't met.
+type BearerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BearerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BearerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BearerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BearerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BearerValidationError) ErrorName() string { return "BearerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BearerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBearer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BearerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BearerValidationError{}
+
+// Validate checks the field values on ConsumerConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsumerConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsumerConfigMultiError,
+// or nil if none found.
+func (m *ConsumerConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClaimValue()) < 1 {
+		err := ConsumerConfigValidationError{
+			field:  "ClaimValue",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConsumerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerConfigMultiError is an error wrapping multiple validation errors
+// returned by ConsumerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerConfigMultiError) AllErrors() []error { return m }
+
+// ConsumerConfigValidationError is the validation error returned by
+// ConsumerConfig.Validate if the designated constraints aren't met.
+type ConsumerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerConfigValidationError) ErrorName() string { return "ConsumerConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsumerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerConfigValidationError{}
+
+// Validate checks the field values on Cookie with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Cookie) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Cookie with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CookieMultiError, or nil if none found.
+func (m *Cookie) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Cookie) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Domain
+
+	// no validation rules for Path
+
+	// no validation rules for SameSite
+
+	// no validation rules for Secure
+
+	if len(errors) > 0 {
+		return CookieMultiError(errors)
+	}
+
+	return nil
+}
+
+// CookieMultiError is an error wrapping multiple validation errors returned by
+// Cookie.ValidateAll() if the designated constraints aren't met.
+type CookieMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CookieMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CookieMultiError) AllErrors() []error { return m }
+
+// CookieValidationError is the validation error returned by Cookie.Validate if
+// the designated constraints aren't met.
+type CookieValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CookieValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CookieValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CookieValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CookieValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CookieValidationError) ErrorName() string { return "CookieValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CookieValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCookie.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CookieValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CookieValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRedis()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedis()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "Redis",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on Logout with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Logout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Logout with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogoutMultiError, or nil if none found.
+func (m *Logout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Logout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !strings.HasPrefix(m.GetPath(), "/") {
+		err := LogoutValidationError{
+			field:  "Path",
+			reason: "value does not have prefix \"/\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPostLogoutRedirectUrl() != "" {
+
+		if uri, err := url.Parse(m.GetPostLogoutRedirectUrl()); err != nil {
+			err = LogoutValidationError{
+				field:  "PostLogoutRedirectUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := LogoutValidationError{
+				field:  "PostLogoutRedirectUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LogoutMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutMultiError is an error wrapping multiple validation errors returned by
+// Logout.ValidateAll() if the designated constraints aren't met.
+type LogoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutMultiError) AllErrors() []error { return m }
+
+// LogoutValidationError is the validation error returned by Logout.Validate if
+// the designated constraints aren't met.
+type LogoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutValidationError) ErrorName() string { return "LogoutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutValidationError{}
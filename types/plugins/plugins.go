@@ -17,9 +17,13 @@ package plugins
 import (
 	_ "mosn.io/htnn/types/dynamicconfigs"
 	_ "mosn.io/htnn/types/plugins/bandwidthlimit"
+	_ "mosn.io/htnn/types/plugins/basicauth"
 	_ "mosn.io/htnn/types/plugins/buffer"
 	_ "mosn.io/htnn/types/plugins/casbin"
 	_ "mosn.io/htnn/types/plugins/celscript"
+	_ "mosn.io/htnn/types/plugins/celtransform"
+	_ "mosn.io/htnn/types/plugins/certauth"
+	_ "mosn.io/htnn/types/plugins/consumerimport"
 	_ "mosn.io/htnn/types/plugins/consumerrestriction"
 	_ "mosn.io/htnn/types/plugins/cors"
 	_ "mosn.io/htnn/types/plugins/debugmode"
@@ -28,12 +32,14 @@ import (
 	_ "mosn.io/htnn/types/plugins/extproc"
 	_ "mosn.io/htnn/types/plugins/fault"
 	_ "mosn.io/htnn/types/plugins/hmacauth"
+	_ "mosn.io/htnn/types/plugins/jwtauth"
 	_ "mosn.io/htnn/types/plugins/keyauth"
 	_ "mosn.io/htnn/types/plugins/limitcountredis"
 	_ "mosn.io/htnn/types/plugins/limitreq"
 	_ "mosn.io/htnn/types/plugins/listenerpatch"
 	_ "mosn.io/htnn/types/plugins/localratelimit"
 	_ "mosn.io/htnn/types/plugins/lua"
+	_ "mosn.io/htnn/types/plugins/multiauth"
 	_ "mosn.io/htnn/types/plugins/networkrbac"
 	_ "mosn.io/htnn/types/plugins/oidc"
 	_ "mosn.io/htnn/types/plugins/opa"